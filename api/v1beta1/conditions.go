@@ -0,0 +1,177 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"github.com/openstack-k8s-operators/lib-common/modules/common/condition"
+)
+
+// Designate Condition Types used by API objects.
+const (
+	// DesignateAPIAvailableCondition Status=True condition when at least the minimum ready ratio of
+	// the desired replicas is ready
+	DesignateAPIAvailableCondition condition.Type = "Available"
+
+	// DesignateAPIProgressingCondition Status=True condition while a rollout of the deployment is
+	// in progress or complete, Status=False only when the rollout exceeded its progress deadline
+	DesignateAPIProgressingCondition condition.Type = "Progressing"
+
+	// DesignateAPIDegradedCondition Status=True condition when the deployment exceeded its progress
+	// deadline or less than the minimum ready ratio of the replicas is ready after the rollout.
+	// Other than the other conditions it reports a problem with Status=True.
+	DesignateAPIDegradedCondition condition.Type = "Degraded"
+
+	// DesignateZoneReadyCondition Status=True condition when the zone is active in designate
+	DesignateZoneReadyCondition condition.Type = "DesignateZoneReady"
+
+	// DesignateRecordSetReadyCondition Status=True condition when the recordset is active in designate
+	DesignateRecordSetReadyCondition condition.Type = "DesignateRecordSetReady"
+
+	// DesignateQuotaReadyCondition Status=True condition when the quotas of the project got applied
+	DesignateQuotaReadyCondition condition.Type = "DesignateQuotaReady"
+
+	// DesignateTsigKeyReadyCondition Status=True condition when the key is registered with designate
+	DesignateTsigKeyReadyCondition condition.Type = "DesignateTsigKeyReady"
+
+	// DesignateBlacklistReadyCondition Status=True condition when the blacklist entry exists in designate
+	DesignateBlacklistReadyCondition condition.Type = "DesignateBlacklistReady"
+
+	// DesignateTLDReadyCondition Status=True condition when the TLD exists in designate
+	DesignateTLDReadyCondition condition.Type = "DesignateTLDReady"
+)
+
+// Designate Reasons used by API objects.
+const (
+	// ProgressDeadlineExceededReason - the rollout of the deployment did not progress in time
+	ProgressDeadlineExceededReason condition.Reason = "ProgressDeadlineExceeded"
+
+	// InsufficientReplicasReason - less than the minimum ready ratio of the replicas is ready
+	InsufficientReplicasReason condition.Reason = "InsufficientReplicas"
+)
+
+// Common Messages used by API objects.
+const (
+	// DesignateAPIAvailableInitMessage
+	DesignateAPIAvailableInitMessage = "Availability not checked"
+
+	// DesignateAPIAvailableMessage
+	DesignateAPIAvailableMessage = "%d of %d replicas ready"
+
+	// DesignateAPIAvailableErrorMessage
+	DesignateAPIAvailableErrorMessage = "%d of %d replicas ready, %d required"
+
+	// DesignateAPIProgressingInitMessage
+	DesignateAPIProgressingInitMessage = "Rollout not started"
+
+	// DesignateAPIProgressingMessage
+	DesignateAPIProgressingMessage = "Rollout in progress, %d of %d replicas updated"
+
+	// DesignateAPIProgressingCompleteMessage
+	DesignateAPIProgressingCompleteMessage = "Rollout complete"
+
+	// DesignateAPIDegradedInitMessage
+	DesignateAPIDegradedInitMessage = "Degradation not checked"
+
+	// DesignateAPIDegradedMessage
+	DesignateAPIDegradedMessage = "Not degraded"
+
+	// DesignateAPINotReadyMessage
+	DesignateAPINotReadyMessage = "Service not ready anymore, check the other conditions"
+
+	// DesignateNotReadyMessage
+	DesignateNotReadyMessage = "Not ready anymore, check the other conditions"
+
+	// DesignateAPIWaitingMessage
+	DesignateAPIWaitingMessage = "Waiting for DesignateAPI %s to be ready"
+
+	// DesignateZoneReadyInitMessage
+	DesignateZoneReadyInitMessage = "Zone not synced"
+
+	// DesignateZoneReadyMessage
+	DesignateZoneReadyMessage = "Zone active"
+
+	// DesignateZoneReadyRunningMessage
+	DesignateZoneReadyRunningMessage = "Zone %s pending"
+
+	// DesignateZoneReadyErrorMessage
+	DesignateZoneReadyErrorMessage = "Zone error occurred %s"
+
+	// DesignateZoneConflictMessage
+	DesignateZoneConflictMessage = "Zone %s is managed by DesignateZone %s"
+
+	// DesignateZoneWaitingMessage
+	DesignateZoneWaitingMessage = "Waiting for DesignateZone %s to be created"
+
+	// DesignateRecordSetReadyInitMessage
+	DesignateRecordSetReadyInitMessage = "Recordset not synced"
+
+	// DesignateRecordSetReadyMessage
+	DesignateRecordSetReadyMessage = "Recordset active"
+
+	// DesignateRecordSetReadyRunningMessage
+	DesignateRecordSetReadyRunningMessage = "Recordset %s pending"
+
+	// DesignateRecordSetReadyErrorMessage
+	DesignateRecordSetReadyErrorMessage = "Recordset error occurred %s"
+
+	// DesignateRecordSetConflictMessage
+	DesignateRecordSetConflictMessage = "Recordset %s is managed by DesignateRecordSet %s"
+
+	// DesignateQuotaReadyInitMessage
+	DesignateQuotaReadyInitMessage = "Quotas not applied"
+
+	// DesignateQuotaReadyMessage
+	DesignateQuotaReadyMessage = "Quotas applied"
+
+	// DesignateQuotaReadyErrorMessage
+	DesignateQuotaReadyErrorMessage = "Quota error occurred %s"
+
+	// DesignateTsigKeyReadyInitMessage
+	DesignateTsigKeyReadyInitMessage = "TSIG key not registered"
+
+	// DesignateTsigKeyReadyMessage
+	DesignateTsigKeyReadyMessage = "TSIG key registered"
+
+	// DesignateTsigKeyReadyErrorMessage
+	DesignateTsigKeyReadyErrorMessage = "TSIG key error occurred %s"
+
+	// DesignateTsigKeyConflictMessage
+	DesignateTsigKeyConflictMessage = "TSIG key %s is managed by DesignateTsigKey %s"
+
+	// DesignateBlacklistReadyInitMessage
+	DesignateBlacklistReadyInitMessage = "Blacklist entry not synced"
+
+	// DesignateBlacklistReadyMessage
+	DesignateBlacklistReadyMessage = "Blacklist entry synced"
+
+	// DesignateBlacklistReadyErrorMessage
+	DesignateBlacklistReadyErrorMessage = "Blacklist entry error occurred %s"
+
+	// DesignateBlacklistConflictMessage
+	DesignateBlacklistConflictMessage = "Blacklist entry %s is managed by DesignateBlacklist %s"
+
+	// DesignateTLDReadyInitMessage
+	DesignateTLDReadyInitMessage = "TLD not synced"
+
+	// DesignateTLDReadyMessage
+	DesignateTLDReadyMessage = "TLD synced"
+
+	// DesignateTLDReadyErrorMessage
+	DesignateTLDReadyErrorMessage = "TLD error occurred %s"
+
+	// DesignateTLDConflictMessage
+	DesignateTLDConflictMessage = "TLD %s is managed by DesignateTLD %s"
+)
@@ -31,14 +31,71 @@ const (
 
 	// DeploymentHash hash used to detect changes
 	DeploymentHash = "deployment"
+
+	// DeletionPolicyRetain - keep the resource when the DesignateAPI gets deleted
+	DeletionPolicyRetain DeletionPolicy = "Retain"
+	// DeletionPolicyDelete - delete the resource when the DesignateAPI gets deleted
+	DeletionPolicyDelete DeletionPolicy = "Delete"
+
+	// LogFormatText - plain text log messages
+	LogFormatText LogFormat = "text"
+	// LogFormatJSON - one JSON object per log message
+	LogFormatJSON LogFormat = "json"
+
+	// LogLevelDebug -
+	LogLevelDebug LogLevel = "DEBUG"
 )
 
+// DesignateAPIPhase - summary of the conditions of the DesignateAPI
+type DesignateAPIPhase string
+
+const (
+	// PhaseConfiguring - waiting for the input secret and the service config
+	PhaseConfiguring DesignateAPIPhase = "Configuring"
+	// PhaseDBCreating - waiting for the database
+	PhaseDBCreating DesignateAPIPhase = "DBCreating"
+	// PhaseDBSyncing - waiting for the db-sync job
+	PhaseDBSyncing DesignateAPIPhase = "DBSyncing"
+	// PhaseExposing - waiting for the services and routes
+	PhaseExposing DesignateAPIPhase = "Exposing"
+	// PhaseRegistering - waiting for the keystone service and endpoints
+	PhaseRegistering DesignateAPIPhase = "Registering"
+	// PhaseDeploying - waiting for the deployment
+	PhaseDeploying DesignateAPIPhase = "Deploying"
+	// PhaseReady - all conditions are ready
+	PhaseReady DesignateAPIPhase = "Ready"
+	// PhaseDegraded - a condition failed with a warning or error
+	PhaseDegraded DesignateAPIPhase = "Degraded"
+	// PhaseDeleting - the DesignateAPI gets deleted
+	PhaseDeleting DesignateAPIPhase = "Deleting"
+)
+
+// phaseConditions - the conditions in the order the reconcile handles them with the
+// phase of the DesignateAPI while the condition is not ready
+var phaseConditions = []struct {
+	condition condition.Type
+	phase     DesignateAPIPhase
+}{
+	{condition.InputReadyCondition, PhaseConfiguring},
+	{condition.ServiceConfigReadyCondition, PhaseConfiguring},
+	{condition.DBReadyCondition, PhaseDBCreating},
+	{condition.DBSyncReadyCondition, PhaseDBSyncing},
+	{condition.ExposeServiceReadyCondition, PhaseExposing},
+	{condition.KeystoneServiceReadyCondition, PhaseRegistering},
+	{condition.KeystoneEndpointReadyCondition, PhaseRegistering},
+	{condition.DeploymentReadyCondition, PhaseDeploying},
+}
+
+// DeletionPolicy - defines what happens to a resource when the DesignateAPI gets deleted
+// +kubebuilder:validation:Enum=Retain;Delete
+type DeletionPolicy string
+
 // NOTE: json tags are required.  Any new fields you add must have json tags for the fields to be serialized.
 
 // DesignateAPISpec defines the desired state of DesignateAPI
 type DesignateAPISpec struct {
-  // INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
-  // Important: Run "make" to regenerate code after modifying this file
+	// INSERT ADDITIONAL SPEC FIELDS - desired state of cluster
+	// Important: Run "make" to regenerate code after modifying this file
 
 	// +kubebuilder:validation:Required
 	// MariaDB instance name
@@ -57,8 +114,8 @@ type DesignateAPISpec struct {
 	// ServiceUser - optional username used for this service to register in designate
 	ServiceUser string `json:"serviceUser"`
 
-  // +kubebuilder:validation:Required
-  // Designate Container Image URL
+	// +kubebuilder:validation:Required
+	// Designate Container Image URL
 	ContainerImage string `json:"containerImage"`
 
 	// +kubebuilder:validation:Optional
@@ -68,15 +125,35 @@ type DesignateAPISpec struct {
 	// Replicas of designate API to run
 	Replicas int32 `json:"replicas"`
 
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=100
+	// +kubebuilder:validation:Maximum=100
+	// +kubebuilder:validation:Minimum=1
+	// MinReadyPercent - minimum ratio of ready replicas, in percent of the desired replicas,
+	// for the DesignateAPI to be available. Below it the DesignateAPI is degraded after the rollout
+	MinReadyPercent int32 `json:"minReadyPercent,omitempty"`
+
 	// +kubebuilder:validation:Required
 	// Secret containing OpenStack password information for designate DesignateDatabasePassword, AdminPassword
 	Secret string `json:"secret"`
 
 	// +kubebuilder:validation:Optional
-  // +kubebuilder:default={database: DesignateDatabasePassword, service: DesignatePassword}
+	// +kubebuilder:default={database: DesignateDatabasePassword, service: DesignatePassword}
 	// PasswordSelectors - Selectors to identify the DB and AdminUser password from the Secret
 	PasswordSelectors PasswordSelector `json:"passwordSelectors,omitempty"`
 
+	// +kubebuilder:validation:Required
+	// TransportURLSecret - name of the Secret holding the oslo.messaging transport_url of the message bus
+	// designate-api and designate-central communicate and send their notifications over, with the key
+	// transport_url. Use the same Secret as the DesignateSink.
+	TransportURLSecret string `json:"transportURLSecret"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default={database: Delete, keystone: Delete}
+	// DeletionPolicy - defines if the database and the keystone registrations get deleted or retained
+	// when the DesignateAPI gets deleted
+	DeletionPolicy DesignateAPIDeletionPolicy `json:"deletionPolicy,omitempty"`
+
 	// +kubebuilder:validation:Optional
 	// NodeSelector to target subset of worker nodes running this service
 	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
@@ -94,8 +171,8 @@ type DesignateAPISpec struct {
 	// +kubebuilder:validation:Optional
 	// +kubebuilder:default="# add your customization here"
 	// CustomServiceConfig - customize the service config using this parameter to change service defaults,
-	// or overwrite rendered information using raw OpenStack config format. The content gets added to
-	// to /etc/<service>/<service>.conf.d directory as custom.conf file.
+	// or overwrite rendered information using raw OpenStack config format. The content gets merged
+	// into the rendered /etc/<service>/<service>.conf file.
 	CustomServiceConfig string `json:"customServiceConfig,omitempty"`
 
 	// +kubebuilder:validation:Optional
@@ -104,6 +181,44 @@ type DesignateAPISpec struct {
 	// TODO: -> implement
 	DefaultConfigOverwrite map[string]string `json:"defaultConfigOverwrite,omitempty"`
 
+	// +kubebuilder:validation:Optional
+	// Region - optional keystone region to register the service endpoints in and to configure the
+	// service with, defaults to the region of the KeystoneAPI
+	Region string `json:"region,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default={format: text, level: INFO}
+	// Logging - format and levels of the designate logs, which get written to stdout
+	Logging DesignateAPILogging `json:"logging,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default={enabled: false}
+	// Metrics - optional exporter sidecar publishing the designate API request statistics
+	// and a ServiceMonitor to scrape it
+	Metrics DesignateAPIMetrics `json:"metrics,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default={enabled: false}
+	// NeutronDNS - optional secret holding the config neutron needs to use designate as its
+	// external DNS driver, e.g. to publish the dns_name of ports
+	NeutronDNS DesignateAPINeutronDNS `json:"neutronDNS,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default={}
+	// Quotas - default quotas of all projects, DesignateQuotas override them per project
+	Quotas DesignateAPIQuotas `json:"quotas,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default={}
+	// APIServer - tuning of the httpd and the mod_wsgi daemon processes serving the designate API
+	APIServer DesignateAPIServer `json:"apiServer,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// Policy - oslo.policy rules overriding the designate defaults, rendered to /etc/designate/policy.yaml,
+	// e.g. {"create_zone_transfer_request": "role:dns_admin or rule:admin_or_owner"}. Only the rule
+	// names designate knows are accepted, changes restart the designate API.
+	Policy map[string]string `json:"policy,omitempty"`
+
 	// +kubebuilder:validation:Optional
 	// Resources - Compute Resources required by this service (Limits/Requests).
 	// https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/
@@ -123,6 +238,135 @@ type PasswordSelector struct {
 	Service string `json:"service,omitempty"`
 }
 
+// DesignateAPIDeletionPolicy defines what happens to the resources of the DesignateAPI on delete
+type DesignateAPIDeletionPolicy struct {
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=Delete
+	// Database - Delete drops the database schema and user, Retain detaches the MariaDBDatabase
+	// from the DesignateAPI so that the data survives
+	Database DeletionPolicy `json:"database,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=Delete
+	// Keystone - Delete removes the service and endpoints from keystone, Retain detaches the
+	// KeystoneService and KeystoneEndpoint from the DesignateAPI and keeps the registrations
+	Keystone DeletionPolicy `json:"keystone,omitempty"`
+}
+
+// LogFormat - format of the log messages
+// +kubebuilder:validation:Enum=text;json
+type LogFormat string
+
+// LogLevel - python logging level
+// +kubebuilder:validation:Enum=DEBUG;INFO;WARNING;ERROR;CRITICAL
+type LogLevel string
+
+// DesignateAPILogging defines the logging of the DesignateAPI
+type DesignateAPILogging struct {
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=text
+	// Format - text or json formatted log messages
+	Format LogFormat `json:"format,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=INFO
+	// Level - level of the root logger, DEBUG also enables the debug option of designate
+	Level LogLevel `json:"level,omitempty"`
+	// +kubebuilder:validation:Optional
+	// Loggers - per logger levels overriding the root level, e.g. {"sqlalchemy": "WARNING"}
+	Loggers map[string]LogLevel `json:"loggers,omitempty"`
+}
+
+// DesignateAPIMetrics defines the metrics exporter sidecar of the DesignateAPI
+type DesignateAPIMetrics struct {
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=false
+	// Enabled - run the exporter sidecar and create a ServiceMonitor for it
+	Enabled bool `json:"enabled,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="quay.io/prometheuscommunity/apache-exporter:v0.13.0"
+	// ContainerImage - exporter image which converts the httpd mod_status of the API to metrics
+	ContainerImage string `json:"containerImage,omitempty"`
+}
+
+// DesignateAPINeutronDNS defines the external DNS driver config the DesignateAPI publishes for neutron
+type DesignateAPINeutronDNS struct {
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=false
+	// Enabled - publish the <name>-neutron-dns secret holding the [designate] section of the neutron
+	// config. It gets updated when the endpoints or the service password change
+	Enabled bool `json:"enabled,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=true
+	// AllowReverseDNSLookup - neutron creates PTR records for the ports and floating IPs
+	AllowReverseDNSLookup bool `json:"allowReverseDNSLookup"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=24
+	// +kubebuilder:validation:Minimum=8
+	// +kubebuilder:validation:Maximum=24
+	// IPv4PTRZonePrefixSize - prefix size of the IPv4 reverse lookup zones neutron creates
+	IPv4PTRZonePrefixSize int32 `json:"ipv4PTRZonePrefixSize,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=116
+	// +kubebuilder:validation:Minimum=4
+	// +kubebuilder:validation:Maximum=124
+	// IPv6PTRZonePrefixSize - prefix size of the IPv6 reverse lookup zones neutron creates
+	IPv6PTRZonePrefixSize int32 `json:"ipv6PTRZonePrefixSize,omitempty"`
+}
+
+// DesignateAPIQuotas defines the default quotas designate enforces for every project
+type DesignateAPIQuotas struct {
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=10
+	// +kubebuilder:validation:Minimum=1
+	// Zones - number of zones a project can create
+	Zones int32 `json:"zones,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=500
+	// +kubebuilder:validation:Minimum=1
+	// ZoneRecordsets - number of recordsets of a zone
+	ZoneRecordsets int32 `json:"zoneRecordsets,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=500
+	// +kubebuilder:validation:Minimum=1
+	// ZoneRecords - number of records of a zone
+	ZoneRecords int32 `json:"zoneRecords,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=20
+	// +kubebuilder:validation:Minimum=1
+	// RecordsetRecords - number of records of a recordset
+	RecordsetRecords int32 `json:"recordsetRecords,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=1000
+	// +kubebuilder:validation:Minimum=1
+	// APIExportSize - number of recordsets of a zone export
+	APIExportSize int32 `json:"apiExportSize,omitempty"`
+}
+
+// DesignateAPIServer defines the tuning of the httpd serving the designate API
+type DesignateAPIServer struct {
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// Processes - number of mod_wsgi daemon processes, defaults to two per core of the CPU limit
+	// of the Resources rounded up, or to 5 without a CPU limit
+	Processes int32 `json:"processes,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Minimum=1
+	// Threads - number of threads of every mod_wsgi daemon process
+	Threads int32 `json:"threads,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=60
+	// +kubebuilder:validation:Minimum=1
+	// RequestTimeout - seconds httpd waits for a request to be read and mod_wsgi for the designate
+	// API to answer it, before the request is aborted
+	RequestTimeout int32 `json:"requestTimeout,omitempty"`
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=10485760
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=2147483647
+	// MaxRequestBodySize - maximum size of a request body in bytes, e.g. of a zone file import
+	MaxRequestBodySize int64 `json:"maxRequestBodySize,omitempty"`
+}
+
 // DesignateAPIDebug defines the observed state of DesignateAPIDebug
 type DesignateAPIDebug struct {
 	// +kubebuilder:validation:Optional
@@ -146,6 +390,13 @@ type DesignateAPIStatus struct {
 	// API endpoint
 	APIEndpoints map[string]string `json:"apiEndpoint,omitempty"`
 
+	// Region - the keystone region the API endpoints are registered in
+	Region string `json:"region,omitempty"`
+
+	// RegionEndpointIDs - the IDs of the endpoints registered in a region other than the region of
+	// the KeystoneAPI, with the endpoint type as index
+	RegionEndpointIDs map[string]string `json:"regionEndpointIDs,omitempty"`
+
 	// Conditions
 	Conditions condition.Conditions `json:"conditions,omitempty" optional:"true"`
 
@@ -154,10 +405,25 @@ type DesignateAPIStatus struct {
 
 	// ServiceID - the ID of the registered service in keystone
 	ServiceID string `json:"serviceID,omitempty"`
+
+	// Phase - summary of the conditions, the reconcile step the DesignateAPI is at
+	Phase DesignateAPIPhase `json:"phase,omitempty"`
+
+	// ObservedGeneration - the generation of the spec which got fully reconciled. The conditions
+	// only describe the current spec if it matches metadata.generation
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// NeutronDNSSecret - name of the secret holding the external DNS driver config for neutron
+	NeutronDNSSecret string `json:"neutronDNSSecret,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase",description="Phase"
+// +kubebuilder:printcolumn:name="Ready",type="integer",JSONPath=".status.readyCount",description="Ready replicas"
+// +kubebuilder:printcolumn:name="Replicas",type="integer",JSONPath=".spec.replicas",description="Desired replicas"
+// +kubebuilder:printcolumn:name="Endpoint",type="string",JSONPath=".status.apiEndpoint.public",description="Public endpoint"
+// +kubebuilder:printcolumn:name="Image",type="string",JSONPath=".spec.containerImage",description="Image",priority=1
 // +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.conditions[0].status",description="Status"
 // +kubebuilder:printcolumn:name="Message",type="string",JSONPath=".status.conditions[0].message",description="Message"
 
@@ -191,8 +457,48 @@ func (instance DesignateAPI) GetEndpoint(endpointType endpoint.Endpoint) (string
 	return "", fmt.Errorf("%s endpoint not found", string(endpointType))
 }
 
+// GetRegion - returns the keystone region of the service, which is the Spec.Region if set
+// or the defaultRegion, usually the region of the KeystoneAPI
+func (instance DesignateAPI) GetRegion(defaultRegion string) string {
+	if instance.Spec.Region != "" {
+		return instance.Spec.Region
+	}
+	return defaultRegion
+}
+
 // IsReady - returns true if service is ready to server requests
 func (instance DesignateAPI) IsReady() bool {
 	return instance.Status.Conditions.IsTrue(condition.ExposeServiceReadyCondition) &&
+		instance.Status.Conditions.IsTrue(condition.KeystoneServiceReadyCondition) &&
+		instance.Status.Conditions.IsTrue(condition.KeystoneEndpointReadyCondition) &&
 		instance.Status.Conditions.IsTrue(condition.DeploymentReadyCondition)
 }
+
+// GetPhase - returns the phase derived from the conditions. The first condition in reconcile order
+// which is not ready defines the phase, or Degraded if it failed with a warning or an error.
+func (instance DesignateAPI) GetPhase() DesignateAPIPhase {
+	if !instance.DeletionTimestamp.IsZero() {
+		return PhaseDeleting
+	}
+
+	if instance.Status.Conditions.IsTrue(DesignateAPIDegradedCondition) {
+		return PhaseDegraded
+	}
+
+	for _, pc := range phaseConditions {
+		c := instance.Status.Conditions.Get(pc.condition)
+		if c == nil || c.Status == corev1.ConditionTrue {
+			continue
+		}
+		if c.Status == corev1.ConditionFalse &&
+			(c.Severity == condition.SeverityWarning || c.Severity == condition.SeverityError) {
+			return PhaseDegraded
+		}
+		return pc.phase
+	}
+
+	if instance.IsReady() && instance.Status.ObservedGeneration == instance.Generation {
+		return PhaseReady
+	}
+	return PhaseConfiguring
+}
@@ -0,0 +1,224 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"fmt"
+	"sort"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// log is for logging in this package.
+var designateapilog = logf.Log.WithName("designateapi-resource")
+
+// designatePolicyRules - names of the policy rules designate registers, see designate/common/policies
+var designatePolicyRules = map[string]bool{
+	// base
+	"admin":                    true,
+	"primary_zone":             true,
+	"owner":                    true,
+	"admin_or_owner":           true,
+	"default":                  true,
+	"target":                   true,
+	"owner_or_target":          true,
+	"admin_or_owner_or_target": true,
+	"admin_or_target":          true,
+	"zone_primary_or_admin":    true,
+	// context
+	"all_tenants":          true,
+	"edit_managed_records": true,
+	"use_low_ttl":          true,
+	"use_sudo":             true,
+	"hard_delete":          true,
+	// blacklist
+	"create_blacklist":     true,
+	"find_blacklist":       true,
+	"find_blacklists":      true,
+	"get_blacklist":        true,
+	"update_blacklist":     true,
+	"delete_blacklist":     true,
+	"use_blacklisted_zone": true,
+	// diagnostics
+	"diagnostics_ping":        true,
+	"diagnostics_sync_zones":  true,
+	"diagnostics_sync_zone":   true,
+	"diagnostics_sync_record": true,
+	// pool
+	"create_pool":             true,
+	"find_pools":              true,
+	"find_pool":               true,
+	"get_pool":                true,
+	"update_pool":             true,
+	"delete_pool":             true,
+	"zone_create_forced_pool": true,
+	// quota
+	"get_quotas":   true,
+	"get_quota":    true,
+	"set_quota":    true,
+	"reset_quotas": true,
+	// record
+	"find_records":  true,
+	"count_records": true,
+	// recordset
+	"create_recordset": true,
+	"get_recordsets":   true,
+	"get_recordset":    true,
+	"find_recordset":   true,
+	"find_recordsets":  true,
+	"update_recordset": true,
+	"delete_recordset": true,
+	"count_recordset":  true,
+	// service status
+	"find_service_status":   true,
+	"find_service_statuses": true,
+	"update_service_status": true,
+	// tenant
+	"find_tenants":  true,
+	"get_tenant":    true,
+	"count_tenants": true,
+	// tld
+	"create_tld": true,
+	"find_tlds":  true,
+	"get_tld":    true,
+	"update_tld": true,
+	"delete_tld": true,
+	// tsigkey
+	"create_tsigkey": true,
+	"find_tsigkeys":  true,
+	"get_tsigkey":    true,
+	"update_tsigkey": true,
+	"delete_tsigkey": true,
+	// zone
+	"create_zone":                true,
+	"get_zones":                  true,
+	"get_zone":                   true,
+	"get_zone_servers":           true,
+	"get_zone_ns_records":        true,
+	"find_zones":                 true,
+	"update_zone":                true,
+	"delete_zone":                true,
+	"xfr_zone":                   true,
+	"abandon_zone":               true,
+	"count_zones":                true,
+	"count_zones_pending_notify": true,
+	"purge_zones":                true,
+	"touch_zone":                 true,
+	"pool_move_zone":             true,
+	// zone export
+	"zone_export":        true,
+	"create_zone_export": true,
+	"find_zone_exports":  true,
+	"get_zone_export":    true,
+	"update_zone_export": true,
+	"delete_zone_export": true,
+	// zone import
+	"create_zone_import": true,
+	"find_zone_imports":  true,
+	"get_zone_import":    true,
+	"update_zone_import": true,
+	"delete_zone_import": true,
+	// zone transfer accept
+	"create_zone_transfer_accept": true,
+	"get_zone_transfer_accept":    true,
+	"find_zone_transfer_accepts":  true,
+	"find_zone_transfer_accept":   true,
+	"update_zone_transfer_accept": true,
+	"delete_zone_transfer_accept": true,
+	// zone transfer request
+	"create_zone_transfer_request":       true,
+	"get_zone_transfer_request":          true,
+	"get_zone_transfer_request_detailed": true,
+	"find_zone_transfer_requests":        true,
+	"find_zone_transfer_request":         true,
+	"update_zone_transfer_request":       true,
+	"delete_zone_transfer_request":       true,
+	// shared zones
+	"share_zone":       true,
+	"unshare_zone":     true,
+	"find_zone_shares": true,
+	"get_zone_share":   true,
+}
+
+// SetupWebhookWithManager - registers the webhooks of the DesignateAPI with the manager
+func (r *DesignateAPI) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/validate-designate-openstack-org-v1beta1-designateapi,mutating=false,failurePolicy=fail,sideEffects=None,groups=designate.openstack.org,resources=designateapis,verbs=create;update,versions=v1beta1,name=vdesignateapi.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Validator = &DesignateAPI{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *DesignateAPI) ValidateCreate() error {
+	designateapilog.Info("validate create", "name", r.Name)
+
+	return r.validate(nil)
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *DesignateAPI) ValidateUpdate(old runtime.Object) error {
+	designateapilog.Info("validate update", "name", r.Name)
+
+	// the finalizer of a DesignateAPI which gets deleted has to be removable whatever its spec holds
+	if !r.DeletionTimestamp.IsZero() {
+		return nil
+	}
+	oldInstance, ok := old.(*DesignateAPI)
+	if !ok {
+		return apierrors.NewInternalError(fmt.Errorf("expected a DesignateAPI, got %T", old))
+	}
+	return r.validate(oldInstance.Spec.Policy)
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *DesignateAPI) ValidateDelete() error {
+	return nil
+}
+
+// validate - rejects policy rules designate does not know, designate would only log a warning
+// and the override would silently have no effect. Rules of the oldPolicy are accepted, so a
+// DesignateAPI holding a rule a newer designate dropped can still be updated.
+func (r *DesignateAPI) validate(oldPolicy map[string]string) error {
+	var allErrs field.ErrorList
+
+	rules := make([]string, 0, len(r.Spec.Policy))
+	for rule := range r.Spec.Policy {
+		rules = append(rules, rule)
+	}
+	sort.Strings(rules)
+	for _, rule := range rules {
+		if _, existed := oldPolicy[rule]; !designatePolicyRules[rule] && !existed {
+			allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "policy").Key(rule), rule, "unknown designate policy rule"))
+		}
+	}
+
+	if len(allErrs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(
+		schema.GroupKind{Group: GroupVersion.Group, Kind: "DesignateAPI"},
+		r.Name,
+		allErrs)
+}
@@ -0,0 +1,78 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestDesignateAPIValidatePolicy(t *testing.T) {
+	tests := []struct {
+		name   string
+		policy map[string]string
+		valid  bool
+	}{
+		{"no policy", nil, true},
+		{"known rules", map[string]string{
+			"create_zone_transfer_request": "role:dns_admin or rule:admin_or_owner",
+			"create_zone_transfer_accept":  "role:dns_admin or rule:admin_or_owner",
+			"admin":                        "role:admin or is_admin:True",
+		}, true},
+		{"unknown rule", map[string]string{"create_zone_transfer": "role:dns_admin"}, false},
+		{"unknown and known rule", map[string]string{
+			"create_zone": "rule:admin_or_owner",
+			"CREATE_ZONE": "rule:admin_or_owner",
+		}, false},
+	}
+
+	for _, tc := range tests {
+		instance := &DesignateAPI{Spec: DesignateAPISpec{Policy: tc.policy}}
+		err := instance.ValidateCreate()
+		if tc.valid && err != nil {
+			t.Errorf("%s: rejected: %v", tc.name, err)
+		}
+		if !tc.valid && !apierrors.IsInvalid(err) {
+			t.Errorf("%s: not rejected as invalid: %v", tc.name, err)
+		}
+		if updateErr := instance.ValidateUpdate(&DesignateAPI{}); (err == nil) != (updateErr == nil) {
+			t.Errorf("%s: validated differently on update: %v", tc.name, updateErr)
+		}
+		// rules which are already there are kept
+		if updateErr := instance.ValidateUpdate(instance.DeepCopy()); updateErr != nil {
+			t.Errorf("%s: update without a new rule rejected: %v", tc.name, updateErr)
+		}
+	}
+}
+
+func TestDesignateAPIValidateUpdateNewUnknownRule(t *testing.T) {
+	old := &DesignateAPI{Spec: DesignateAPISpec{Policy: map[string]string{"create_zone_transfer": "role:dns_admin"}}}
+	instance := old.DeepCopy()
+	instance.Spec.Policy["get_zone_transfer"] = "role:dns_admin"
+
+	if err := instance.ValidateUpdate(old); !apierrors.IsInvalid(err) {
+		t.Errorf("new unknown rule not rejected as invalid: %v", err)
+	}
+
+	// the finalizer gets removed while the DesignateAPI gets deleted
+	now := metav1.Now()
+	instance.DeletionTimestamp = &now
+	if err := instance.ValidateUpdate(old); err != nil {
+		t.Errorf("update of a deleted DesignateAPI rejected: %v", err)
+	}
+}
@@ -0,0 +1,93 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"github.com/openstack-k8s-operators/lib-common/modules/common/condition"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DesignateBlacklistSpec defines the desired state of DesignateBlacklist
+type DesignateBlacklistSpec struct {
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=designate
+	// DesignateAPI - name of the DesignateAPI in the namespace the blacklist entry gets managed with
+	DesignateAPI string `json:"designateAPI"`
+
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// Pattern - regular expression of the zone names projects can not create, e.g.
+	// ^([A-Za-z0-9_\-]+\.)*internal\.corp\.$ . Designate matches it with python re, the webhook validates
+	// it with RE2, so only their shared syntax is accepted: no unicode or POSIX character classes,
+	// \Q..\E, \C, \z, \x{..}, escaped digits, (?<name>..), the U flag or flags after the start.
+	Pattern string `json:"pattern"`
+
+	// +kubebuilder:validation:Optional
+	// Description - description of the blacklist entry
+	Description string `json:"description,omitempty"`
+}
+
+// DesignateBlacklistStatus defines the observed state of DesignateBlacklist
+type DesignateBlacklistStatus struct {
+	// BlacklistID - ID of the blacklist entry in designate
+	BlacklistID string `json:"blacklistID,omitempty"`
+
+	// Adopted - the entry existed in designate before the DesignateBlacklist, it is not deleted
+	// with the DesignateBlacklist
+	Adopted bool `json:"adopted,omitempty"`
+
+	// Conditions
+	Conditions condition.Conditions `json:"conditions,omitempty" optional:"true"`
+
+	// ObservedGeneration - the generation of the spec which got fully reconciled. The conditions
+	// only describe the current spec if it matches metadata.generation
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Pattern",type="string",JSONPath=".spec.pattern",description="Pattern"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".status.blacklistID",description="Blacklist ID"
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.conditions[0].status",description="Status"
+// +kubebuilder:printcolumn:name="Message",type="string",JSONPath=".status.conditions[0].message",description="Message"
+
+// DesignateBlacklist is the Schema for the designateblacklists API
+type DesignateBlacklist struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DesignateBlacklistSpec   `json:"spec,omitempty"`
+	Status DesignateBlacklistStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DesignateBlacklistList contains a list of DesignateBlacklist
+type DesignateBlacklistList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DesignateBlacklist `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&DesignateBlacklist{}, &DesignateBlacklistList{})
+}
+
+// IsReady - returns true if the blacklist entry exists in designate
+func (instance DesignateBlacklist) IsReady() bool {
+	return instance.Status.Conditions.IsTrue(condition.InputReadyCondition) &&
+		instance.Status.Conditions.IsTrue(DesignateBlacklistReadyCondition)
+}
@@ -0,0 +1,161 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+)
+
+// log is for logging in this package.
+var designateblacklistlog = logf.Log.WithName("designateblacklist-resource")
+
+// SetupWebhookWithManager - registers the webhooks of the DesignateBlacklist with the manager
+func (r *DesignateBlacklist) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(r).
+		Complete()
+}
+
+//+kubebuilder:webhook:path=/validate-designate-openstack-org-v1beta1-designateblacklist,mutating=false,failurePolicy=fail,sideEffects=None,groups=designate.openstack.org,resources=designateblacklists,verbs=create;update,versions=v1beta1,name=vdesignateblacklist.kb.io,admissionReviewVersions=v1
+
+var _ webhook.Validator = &DesignateBlacklist{}
+
+// ValidateCreate implements webhook.Validator so a webhook will be registered for the type
+func (r *DesignateBlacklist) ValidateCreate() error {
+	designateblacklistlog.Info("validate create", "name", r.Name)
+
+	return r.validate()
+}
+
+// ValidateUpdate implements webhook.Validator so a webhook will be registered for the type
+func (r *DesignateBlacklist) ValidateUpdate(old runtime.Object) error {
+	designateblacklistlog.Info("validate update", "name", r.Name)
+
+	// the finalizer of a DesignateBlacklist which gets deleted has to be removable whatever its spec
+	// holds, and patterns accepted before stay accepted
+	if !r.DeletionTimestamp.IsZero() {
+		return nil
+	}
+	oldInstance, ok := old.(*DesignateBlacklist)
+	if !ok {
+		return apierrors.NewInternalError(fmt.Errorf("expected a DesignateBlacklist, got %T", old))
+	}
+	if oldInstance.Spec.Pattern == r.Spec.Pattern {
+		return nil
+	}
+	return r.validate()
+}
+
+// ValidateDelete implements webhook.Validator so a webhook will be registered for the type
+func (r *DesignateBlacklist) ValidateDelete() error {
+	return nil
+}
+
+// validate - rejects patterns which are no valid regular expressions, designate would only reject
+// them when it matches the first zone against the pattern
+func (r *DesignateBlacklist) validate() error {
+	var allErrs field.ErrorList
+
+	if _, err := regexp.Compile(r.Spec.Pattern); err != nil {
+		allErrs = append(allErrs, field.Invalid(field.NewPath("spec", "pattern"), r.Spec.Pattern, err.Error()))
+	} else if syntax := pythonIncompatibleSyntax(r.Spec.Pattern); syntax != "" {
+		allErrs = append(allErrs, field.Invalid(
+			field.NewPath("spec", "pattern"),
+			r.Spec.Pattern,
+			fmt.Sprintf("designate matches the pattern with python re, which does not support %s the same way", syntax)))
+	}
+
+	if len(allErrs) == 0 {
+		return nil
+	}
+	return apierrors.NewInvalid(
+		schema.GroupKind{Group: GroupVersion.Group, Kind: "DesignateBlacklist"},
+		r.Name,
+		allErrs)
+}
+
+// re2OnlyEscapes - escapes RE2 accepts which python re rejects or interprets differently
+var re2OnlyEscapes = map[byte]string{
+	'p': "unicode character classes",
+	'P': "unicode character classes",
+	'Q': "quoted literal text",
+	'E': "quoted literal text",
+	'C': "single bytes",
+	'z': "end of text anchors",
+}
+
+// pythonIncompatibleSyntax - returns the syntax of the valid RE2 pattern which python re does not
+// support the same way, or an empty string if the pattern means the same for both
+func pythonIncompatibleSyntax(pattern string) string {
+	inClass := false
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; {
+		case c == '\\' && i+1 < len(pattern):
+			i++
+			next := pattern[i]
+			if syntax, ok := re2OnlyEscapes[next]; ok {
+				return syntax
+			}
+			// python reads backreferences, RE2 octal character codes
+			if next >= '0' && next <= '9' {
+				return "escaped digits"
+			}
+			if next == 'x' && i+1 < len(pattern) && pattern[i+1] == '{' {
+				return "hexadecimal character codes in braces"
+			}
+		case c == '[' && !inClass:
+			inClass = true
+			// a ] right after the opening bracket or its negation is a literal
+			if i+1 < len(pattern) && pattern[i+1] == '^' {
+				i++
+			}
+			if i+1 < len(pattern) && pattern[i+1] == ']' {
+				i++
+			}
+		case c == '[' && inClass && i+1 < len(pattern) && pattern[i+1] == ':':
+			return "POSIX character classes"
+		case c == ']' && inClass:
+			inClass = false
+		case c == '(' && !inClass && strings.HasPrefix(pattern[i:], "(?"):
+			group := pattern[i+2:]
+			if strings.HasPrefix(group, "<") {
+				return "named groups without P"
+			}
+			end := strings.IndexAny(group, ":)")
+			if end <= 0 || strings.Trim(group[:end], "imsU-") != "" {
+				continue
+			}
+			if strings.Contains(group[:end], "U") {
+				return "the ungreedy flag"
+			}
+			// python only accepts flags for the whole pattern at its start
+			if group[end] == ')' && i != 0 {
+				return "flags after the start of the pattern"
+			}
+		}
+	}
+	return ""
+}
@@ -0,0 +1,78 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestDesignateBlacklistValidate(t *testing.T) {
+	tests := []struct {
+		pattern string
+		valid   bool
+	}{
+		{`^([A-Za-z0-9_\-]+\.)*internal\.corp\.$`, true},
+		{`^example\.com\.$`, true},
+		{`^([a-z]+\.corp\.$`, false},
+		{`*.internal.corp.`, false},
+		// valid RE2 which python re rejects or reads differently
+		{`^(?i)[a-z]+\.corp\.$`, false},
+		{`^(?i:[a-z]+)\.corp\.$`, true},
+		{`(?i)^[a-z]+\.corp\.$`, true},
+		{`^(?U)[a-z]+\.corp\.$`, false},
+		{`^(?P<Unit>[a-z]+)\.corp\.$`, true},
+		{`^(?<unit>[a-z]+)\.corp\.$`, false},
+		{`^\pL+\.corp\.$`, false},
+		{`^[[:alpha:]]+\.corp\.$`, false},
+		{`^[]a-z]+\.corp\.$`, true},
+		{`^\Q.\E`, false},
+		{`\.corp\.\z`, false},
+		{`^\x{61}\.corp\.$`, false},
+		{`^\x61\.corp\.$`, true},
+		{`^\141\.corp\.$`, false},
+	}
+
+	for _, tc := range tests {
+		instance := &DesignateBlacklist{Spec: DesignateBlacklistSpec{Pattern: tc.pattern}}
+		err := instance.ValidateCreate()
+		if tc.valid && err != nil {
+			t.Errorf("pattern %s rejected: %v", tc.pattern, err)
+		}
+		if !tc.valid && !apierrors.IsInvalid(err) {
+			t.Errorf("pattern %s not rejected as invalid: %v", tc.pattern, err)
+		}
+		if updateErr := instance.ValidateUpdate(&DesignateBlacklist{}); (err == nil) != (updateErr == nil) {
+			t.Errorf("pattern %s validated differently on update: %v", tc.pattern, updateErr)
+		}
+		// an unchanged pattern is kept
+		if updateErr := instance.ValidateUpdate(instance.DeepCopy()); updateErr != nil {
+			t.Errorf("pattern %s rejected without a change: %v", tc.pattern, updateErr)
+		}
+	}
+}
+
+func TestDesignateBlacklistValidateUpdateDeleted(t *testing.T) {
+	now := metav1.Now()
+	instance := &DesignateBlacklist{Spec: DesignateBlacklistSpec{Pattern: `^\pL+\.corp\.$`}}
+	instance.DeletionTimestamp = &now
+
+	if err := instance.ValidateUpdate(&DesignateBlacklist{}); err != nil {
+		t.Errorf("update of a deleted DesignateBlacklist rejected: %v", err)
+	}
+}
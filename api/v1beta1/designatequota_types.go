@@ -0,0 +1,130 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"github.com/openstack-k8s-operators/lib-common/modules/common/condition"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DesignateQuotaSpec defines the desired state of DesignateQuota
+type DesignateQuotaSpec struct {
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=designate
+	// DesignateAPI - name of the DesignateAPI in the namespace the quotas get managed with. The quotas
+	// of the project not set in the spec are the default quotas of the DesignateAPI.
+	DesignateAPI string `json:"designateAPI"`
+
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="projectID is immutable"
+	// ProjectID - ID of the keystone project the quotas apply to
+	ProjectID string `json:"projectID"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// Zones - number of zones the project can create
+	Zones *int32 `json:"zones,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// ZoneRecordsets - number of recordsets of a zone of the project
+	ZoneRecordsets *int32 `json:"zoneRecordsets,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// ZoneRecords - number of records of a zone of the project
+	ZoneRecords *int32 `json:"zoneRecords,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// RecordsetRecords - number of records of a recordset of the project
+	RecordsetRecords *int32 `json:"recordsetRecords,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// APIExportSize - number of recordsets of a zone export of the project
+	APIExportSize *int32 `json:"apiExportSize,omitempty"`
+}
+
+// DesignateQuotaValues - the quotas designate enforces for a project
+type DesignateQuotaValues struct {
+	Zones            int32 `json:"zones,omitempty"`
+	ZoneRecordsets   int32 `json:"zoneRecordsets,omitempty"`
+	ZoneRecords      int32 `json:"zoneRecords,omitempty"`
+	RecordsetRecords int32 `json:"recordsetRecords,omitempty"`
+	APIExportSize    int32 `json:"apiExportSize,omitempty"`
+}
+
+// DesignateQuotaUsage - the usage of the project quotas. Only the zones quota limits the whole
+// project, so only its usage is tracked. The other quotas limit single zones, recordsets and zone
+// exports, which designate checks when they get changed.
+type DesignateQuotaUsage struct {
+	// Zones - number of zones of the project
+	Zones int32 `json:"zones"`
+}
+
+// DesignateQuotaStatus defines the observed state of DesignateQuota
+type DesignateQuotaStatus struct {
+	// Quotas - the quotas designate reports for the project after applying the spec
+	Quotas *DesignateQuotaValues `json:"quotas,omitempty"`
+
+	// Usage - the usage of the quotas by the project, only the zones are tracked
+	Usage *DesignateQuotaUsage `json:"usage,omitempty"`
+
+	// Conditions
+	Conditions condition.Conditions `json:"conditions,omitempty" optional:"true"`
+
+	// ObservedGeneration - the generation of the spec which got fully reconciled. The conditions
+	// only describe the current spec if it matches metadata.generation
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Project",type="string",JSONPath=".spec.projectID",description="Project ID"
+// +kubebuilder:printcolumn:name="Zones",type="integer",JSONPath=".status.quotas.zones",description="Zone quota"
+// +kubebuilder:printcolumn:name="Zones Used",type="integer",JSONPath=".status.usage.zones",description="Zones of the project"
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.conditions[0].status",description="Status"
+// +kubebuilder:printcolumn:name="Message",type="string",JSONPath=".status.conditions[0].message",description="Message"
+
+// DesignateQuota is the Schema for the designatequotas API
+type DesignateQuota struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DesignateQuotaSpec   `json:"spec,omitempty"`
+	Status DesignateQuotaStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DesignateQuotaList contains a list of DesignateQuota
+type DesignateQuotaList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DesignateQuota `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&DesignateQuota{}, &DesignateQuotaList{})
+}
+
+// IsReady - returns true if the quotas of the project got applied
+func (instance DesignateQuota) IsReady() bool {
+	return instance.Status.Conditions.IsTrue(condition.InputReadyCondition) &&
+		instance.Status.Conditions.IsTrue(DesignateQuotaReadyCondition)
+}
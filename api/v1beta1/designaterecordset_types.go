@@ -0,0 +1,120 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"github.com/openstack-k8s-operators/lib-common/modules/common/condition"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DesignateRecordSetSpec defines the desired state of DesignateRecordSet
+type DesignateRecordSetSpec struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="zoneRef is immutable"
+	// ZoneRef - name of the DesignateZone in the namespace the recordset belongs to
+	ZoneRef string `json:"zoneRef"`
+
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^(\*\.)?([a-zA-Z0-9_]([-a-zA-Z0-9_]*[a-zA-Z0-9_])?\.)+$`
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="name is immutable"
+	// Name - fully qualified name of the recordset with trailing dot, within the zone, e.g. www.example.com.
+	Name string `json:"name"`
+
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=A;AAAA;CNAME;MX;TXT;SRV;PTR
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="type is immutable"
+	// Type - type of the records
+	Type string `json:"type"`
+
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinItems=1
+	// Records - data of the records in the format designate returns them, e.g. "10 mail.example.com."
+	// for MX records or "\"some text\"" for TXT records. Otherwise every sync detects a change.
+	Records []string `json:"records"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// TTL - TTL of the records. Defaults to the TTL of the zone if unset
+	TTL int32 `json:"ttl,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// Description - description of the recordset
+	Description string `json:"description,omitempty"`
+}
+
+// DesignateRecordSetStatus defines the observed state of DesignateRecordSet
+type DesignateRecordSetStatus struct {
+	// ZoneID - ID of the zone in designate the recordset got created in
+	ZoneID string `json:"zoneID,omitempty"`
+
+	// RecordSetID - ID of the recordset in designate
+	RecordSetID string `json:"recordSetID,omitempty"`
+
+	// Adopted - the recordset existed in designate before the DesignateRecordSet, it gets deleted with
+	// the DesignateRecordSet all the same
+	Adopted bool `json:"adopted,omitempty"`
+
+	// RecordSetStatus - status of the recordset in designate, ACTIVE, PENDING or ERROR
+	RecordSetStatus string `json:"recordSetStatus,omitempty"`
+
+	// Action - action designate is processing on the recordset, CREATE, UPDATE, DELETE or NONE
+	Action string `json:"action,omitempty"`
+
+	// Conditions
+	Conditions condition.Conditions `json:"conditions,omitempty" optional:"true"`
+
+	// ObservedGeneration - the generation of the spec which got fully reconciled. The conditions
+	// only describe the current spec if it matches metadata.generation
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Name",type="string",JSONPath=".spec.name",description="Recordset name"
+// +kubebuilder:printcolumn:name="Type",type="string",JSONPath=".spec.type",description="Record type"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".status.recordSetID",description="Recordset ID"
+// +kubebuilder:printcolumn:name="Recordset Status",type="string",JSONPath=".status.recordSetStatus",description="Recordset status in designate"
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.conditions[0].status",description="Status"
+// +kubebuilder:printcolumn:name="Message",type="string",JSONPath=".status.conditions[0].message",description="Message"
+
+// DesignateRecordSet is the Schema for the designaterecordsets API
+type DesignateRecordSet struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DesignateRecordSetSpec   `json:"spec,omitempty"`
+	Status DesignateRecordSetStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DesignateRecordSetList contains a list of DesignateRecordSet
+type DesignateRecordSetList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DesignateRecordSet `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&DesignateRecordSet{}, &DesignateRecordSetList{})
+}
+
+// IsReady - returns true if the recordset is active in designate
+func (instance DesignateRecordSet) IsReady() bool {
+	return instance.Status.Conditions.IsTrue(condition.InputReadyCondition) &&
+		instance.Status.Conditions.IsTrue(DesignateRecordSetReadyCondition)
+}
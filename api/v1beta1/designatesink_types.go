@@ -0,0 +1,172 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"github.com/openstack-k8s-operators/lib-common/modules/common/condition"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DesignateSinkSpec defines the desired state of DesignateSink
+type DesignateSinkSpec struct {
+	// +kubebuilder:validation:Required
+	// Designate Sink Container Image URL
+	ContainerImage string `json:"containerImage"`
+
+	// +kubebuilder:validation:Required
+	// TransportURLSecret - name of the Secret holding the oslo.messaging transport_url of the message bus
+	// the other OpenStack services send their notifications to, with the key transport_url
+	TransportURLSecret string `json:"transportURLSecret"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Maximum=32
+	// +kubebuilder:validation:Minimum=0
+	// Replicas of designate sink to run
+	Replicas int32 `json:"replicas"`
+
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinProperties=1
+	// Handlers - the notification handlers creating records from the notifications of the
+	// other OpenStack services, at least one handler has to be configured
+	Handlers DesignateSinkHandlers `json:"handlers"`
+
+	// +kubebuilder:validation:Optional
+	// NodeSelector to target subset of worker nodes running this service
+	NodeSelector map[string]string `json:"nodeSelector,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// Debug - enable debug for different deploy stages. The sink pod gets started with sleep infinity
+	Debug DesignateSinkDebug `json:"debug,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default="# add your customization here"
+	// CustomServiceConfig - customize the service config using this parameter to change service defaults,
+	// or overwrite rendered information using raw OpenStack config format. The content gets merged
+	// into the rendered /etc/<service>/<service>.conf file.
+	CustomServiceConfig string `json:"customServiceConfig,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// ConfigOverwrite - interface to overwrite default config files like e.g. logging.conf or policy.json.
+	// But can also be used to add additional files. Those get added to the service config dir in /etc/<service> .
+	DefaultConfigOverwrite map[string]string `json:"defaultConfigOverwrite,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// Resources - Compute Resources required by this service (Limits/Requests).
+	// https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
+}
+
+// DesignateSinkHandlers defines the notification handlers of the DesignateSink
+type DesignateSinkHandlers struct {
+	// +kubebuilder:validation:Optional
+	// NovaFixed - creates records for the fixed IPs of nova instances
+	NovaFixed *DesignateSinkHandler `json:"novaFixed,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// NeutronFloatingIP - creates records for neutron floating IPs
+	NeutronFloatingIP *DesignateSinkHandler `json:"neutronFloatingIP,omitempty"`
+}
+
+// DesignateSinkHandler defines the zone and the record names of a notification handler
+type DesignateSinkHandler struct {
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// ZoneID - ID of the designate zone the records get created in
+	ZoneID string `json:"zoneID"`
+
+	// +kubebuilder:validation:Optional
+	// FormatV4 - formats of the names of the A records, e.g. "%(hostname)s.%(zone)s".
+	// Defaults to the formats of designate if empty
+	FormatV4 []string `json:"formatV4,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// FormatV6 - formats of the names of the AAAA records, e.g. "%(hostname)s.%(zone)s".
+	// Defaults to the formats of designate if empty
+	FormatV6 []string `json:"formatV6,omitempty"`
+}
+
+// DesignateSinkDebug defines the debug options of the DesignateSink
+type DesignateSinkDebug struct {
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=false
+	// Service enable debug
+	Service bool `json:"service,omitempty"`
+}
+
+// DesignateSinkStatus defines the observed state of DesignateSink
+type DesignateSinkStatus struct {
+	// ReadyCount of designate sink instances
+	ReadyCount int32 `json:"readyCount,omitempty"`
+
+	// Map of hashes to track e.g. job status
+	Hash map[string]string `json:"hash,omitempty"`
+
+	// Conditions
+	Conditions condition.Conditions `json:"conditions,omitempty" optional:"true"`
+
+	// ObservedGeneration - the generation of the spec which got fully reconciled. The conditions
+	// only describe the current spec if it matches metadata.generation
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Ready",type="integer",JSONPath=".status.readyCount",description="Ready replicas"
+// +kubebuilder:printcolumn:name="Replicas",type="integer",JSONPath=".spec.replicas",description="Desired replicas"
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.conditions[0].status",description="Status"
+// +kubebuilder:printcolumn:name="Message",type="string",JSONPath=".status.conditions[0].message",description="Message"
+
+// DesignateSink is the Schema for the designatesinks API
+type DesignateSink struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DesignateSinkSpec   `json:"spec,omitempty"`
+	Status DesignateSinkStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DesignateSinkList contains a list of DesignateSink
+type DesignateSinkList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DesignateSink `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&DesignateSink{}, &DesignateSinkList{})
+}
+
+// IsReady - returns true if the sink is ready to consume notifications
+func (instance DesignateSink) IsReady() bool {
+	return instance.Status.Conditions.IsTrue(condition.ServiceConfigReadyCondition) &&
+		instance.Status.Conditions.IsTrue(condition.DeploymentReadyCondition)
+}
+
+// GetEnabledHandlers - returns the names of the configured notification handlers
+func (instance DesignateSink) GetEnabledHandlers() []string {
+	handlers := []string{}
+	if instance.Spec.Handlers.NovaFixed != nil {
+		handlers = append(handlers, "nova_fixed")
+	}
+	if instance.Spec.Handlers.NeutronFloatingIP != nil {
+		handlers = append(handlers, "neutron_floatingip")
+	}
+	return handlers
+}
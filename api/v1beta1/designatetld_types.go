@@ -0,0 +1,91 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"github.com/openstack-k8s-operators/lib-common/modules/common/condition"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// DesignateTLDSpec defines the desired state of DesignateTLD
+type DesignateTLDSpec struct {
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=designate
+	// DesignateAPI - name of the DesignateAPI in the namespace the TLD gets managed with
+	DesignateAPI string `json:"designateAPI"`
+
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^([a-zA-Z0-9]([-a-zA-Z0-9]*[a-zA-Z0-9])?\.)*[a-zA-Z0-9]([-a-zA-Z0-9]*[a-zA-Z0-9])?$`
+	// Name - name of the TLD without trailing dot, e.g. com or co.uk . As soon as designate knows a
+	// TLD, projects can only create zones within the known TLDs.
+	Name string `json:"name"`
+
+	// +kubebuilder:validation:Optional
+	// Description - description of the TLD
+	Description string `json:"description,omitempty"`
+}
+
+// DesignateTLDStatus defines the observed state of DesignateTLD
+type DesignateTLDStatus struct {
+	// TLDID - ID of the TLD in designate
+	TLDID string `json:"tldID,omitempty"`
+
+	// Adopted - the TLD existed in designate before the DesignateTLD, it is not deleted with the
+	// DesignateTLD
+	Adopted bool `json:"adopted,omitempty"`
+
+	// Conditions
+	Conditions condition.Conditions `json:"conditions,omitempty" optional:"true"`
+
+	// ObservedGeneration - the generation of the spec which got fully reconciled. The conditions
+	// only describe the current spec if it matches metadata.generation
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="TLD",type="string",JSONPath=".spec.name",description="TLD name"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".status.tldID",description="TLD ID"
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.conditions[0].status",description="Status"
+// +kubebuilder:printcolumn:name="Message",type="string",JSONPath=".status.conditions[0].message",description="Message"
+
+// DesignateTLD is the Schema for the designatetlds API
+type DesignateTLD struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DesignateTLDSpec   `json:"spec,omitempty"`
+	Status DesignateTLDStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DesignateTLDList contains a list of DesignateTLD
+type DesignateTLDList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DesignateTLD `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&DesignateTLD{}, &DesignateTLDList{})
+}
+
+// IsReady - returns true if the TLD exists in designate
+func (instance DesignateTLD) IsReady() bool {
+	return instance.Status.Conditions.IsTrue(condition.InputReadyCondition) &&
+		instance.Status.Conditions.IsTrue(DesignateTLDReadyCondition)
+}
@@ -0,0 +1,139 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"github.com/openstack-k8s-operators/lib-common/modules/common/condition"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TsigKeyScope - the designate resource a TSIG key is attached to
+type TsigKeyScope string
+
+const (
+	// TsigKeyScopePool - the key is used for the transfers of all zones of a pool
+	TsigKeyScopePool TsigKeyScope = "POOL"
+	// TsigKeyScopeZone - the key is used for the transfers of a single zone
+	TsigKeyScopeZone TsigKeyScope = "ZONE"
+
+	// TsigKeyGenerationAnnotation - changing the value of the annotation on a DesignateTsigKey
+	// generates a new secret of the key, e.g. to rotate it
+	TsigKeyGenerationAnnotation = "designate.openstack.org/tsigkey-generation"
+)
+
+// DesignateTsigKeySpec defines the desired state of DesignateTsigKey
+// +kubebuilder:validation:XValidation:rule="self.scope != 'ZONE' || has(self.zoneRef)",message="zoneRef is required for ZONE scoped keys"
+type DesignateTsigKeySpec struct {
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=designate
+	// DesignateAPI - name of the DesignateAPI in the namespace the key gets registered with
+	DesignateAPI string `json:"designateAPI"`
+
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9_]([-.a-zA-Z0-9_]*[a-zA-Z0-9_])?\.?$`
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="name is immutable"
+	// Name - name of the key, which the DNS servers use to look up the key, e.g. transfer-key
+	Name string `json:"name"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=hmac-sha256
+	// +kubebuilder:validation:Enum=hmac-md5;hmac-sha1;hmac-sha224;hmac-sha256;hmac-sha384;hmac-sha512
+	// Algorithm - HMAC algorithm of the key, changing it generates a new secret of the key
+	Algorithm string `json:"algorithm"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=POOL
+	// +kubebuilder:validation:Enum=POOL;ZONE
+	// Scope - POOL keys are used for the transfers of all zones of the pool, ZONE keys for the
+	// transfers of the zone of ZoneRef
+	Scope TsigKeyScope `json:"scope"`
+
+	// +kubebuilder:validation:Optional
+	// PoolID - ID of the pool of POOL scoped keys. Defaults to the default pool of designate
+	PoolID string `json:"poolID,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// ZoneRef - name of the DesignateZone in the namespace of ZONE scoped keys
+	ZoneRef string `json:"zoneRef,omitempty"`
+}
+
+// DesignateTsigKeyStatus defines the observed state of DesignateTsigKey
+type DesignateTsigKeyStatus struct {
+	// TsigKeyID - ID of the key in designate
+	TsigKeyID string `json:"tsigKeyID,omitempty"`
+
+	// Adopted - the key existed in designate before the DesignateTsigKey, it is not deleted with the
+	// DesignateTsigKey
+	Adopted bool `json:"adopted,omitempty"`
+
+	// ResourceID - ID of the pool or zone the key is attached to
+	ResourceID string `json:"resourceID,omitempty"`
+
+	// SecretName - name of the Secret holding the name, algorithm and secret of the key
+	SecretName string `json:"secretName,omitempty"`
+
+	// SecretGeneration - value of the generation annotation the secret of the key got generated for
+	SecretGeneration string `json:"secretGeneration,omitempty"`
+
+	// Conditions
+	Conditions condition.Conditions `json:"conditions,omitempty" optional:"true"`
+
+	// ObservedGeneration - the generation of the spec which got fully reconciled. The conditions
+	// only describe the current spec if it matches metadata.generation
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Key",type="string",JSONPath=".spec.name",description="Key name"
+// +kubebuilder:printcolumn:name="Scope",type="string",JSONPath=".spec.scope",description="Key scope"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".status.tsigKeyID",description="Key ID"
+// +kubebuilder:printcolumn:name="Secret",type="string",JSONPath=".status.secretName",description="Secret of the key"
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.conditions[0].status",description="Status"
+// +kubebuilder:printcolumn:name="Message",type="string",JSONPath=".status.conditions[0].message",description="Message"
+
+// DesignateTsigKey is the Schema for the designatetsigkeys API
+type DesignateTsigKey struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DesignateTsigKeySpec   `json:"spec,omitempty"`
+	Status DesignateTsigKeyStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DesignateTsigKeyList contains a list of DesignateTsigKey
+type DesignateTsigKeyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DesignateTsigKey `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&DesignateTsigKey{}, &DesignateTsigKeyList{})
+}
+
+// IsReady - returns true if the key is registered with designate
+func (instance DesignateTsigKey) IsReady() bool {
+	return instance.Status.Conditions.IsTrue(condition.InputReadyCondition) &&
+		instance.Status.Conditions.IsTrue(DesignateTsigKeyReadyCondition)
+}
+
+// GetSecretGeneration - returns the value of the generation annotation
+func (instance DesignateTsigKey) GetSecretGeneration() string {
+	return instance.Annotations[TsigKeyGenerationAnnotation]
+}
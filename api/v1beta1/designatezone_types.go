@@ -0,0 +1,135 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"github.com/openstack-k8s-operators/lib-common/modules/common/condition"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ZoneType - type of a designate zone
+type ZoneType string
+
+const (
+	// ZoneTypePrimary - the zone is managed by designate
+	ZoneTypePrimary ZoneType = "PRIMARY"
+	// ZoneTypeSecondary - designate transfers the zone from the masters
+	ZoneTypeSecondary ZoneType = "SECONDARY"
+)
+
+// DesignateZoneSpec defines the desired state of DesignateZone
+// +kubebuilder:validation:XValidation:rule="self.type != 'PRIMARY' || has(self.email)",message="email is required for PRIMARY zones"
+// +kubebuilder:validation:XValidation:rule="self.type != 'SECONDARY' || (has(self.masters) && size(self.masters) > 0)",message="masters are required for SECONDARY zones"
+type DesignateZoneSpec struct {
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=designate
+	// DesignateAPI - name of the DesignateAPI in the namespace the zone gets managed with. The zone
+	// is owned by the service project of the designate service user.
+	DesignateAPI string `json:"designateAPI"`
+
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^([a-zA-Z0-9_]([-a-zA-Z0-9_]*[a-zA-Z0-9_])?\.)+$`
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="name is immutable"
+	// Name - fully qualified name of the zone with trailing dot, e.g. example.com.
+	Name string `json:"name"`
+
+	// +kubebuilder:validation:Optional
+	// Email - email of the zone administrator used in the SOA record, required for PRIMARY zones
+	Email string `json:"email,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=1
+	// TTL - default TTL of the records of the zone. Defaults to the default TTL of designate if unset
+	TTL int32 `json:"ttl,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:default=PRIMARY
+	// +kubebuilder:validation:Enum=PRIMARY;SECONDARY
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="type is immutable"
+	// Type - PRIMARY zones are managed by designate, SECONDARY zones get transferred from the masters
+	Type ZoneType `json:"type"`
+
+	// +kubebuilder:validation:Optional
+	// Masters - addresses of the DNS servers SECONDARY zones get transferred from, e.g. 192.0.2.1:53
+	Masters []string `json:"masters,omitempty"`
+
+	// +kubebuilder:validation:Optional
+	// Description - description of the zone
+	Description string `json:"description,omitempty"`
+}
+
+// DesignateZoneStatus defines the observed state of DesignateZone
+type DesignateZoneStatus struct {
+	// ZoneID - ID of the zone in designate
+	ZoneID string `json:"zoneID,omitempty"`
+
+	// Adopted - the zone existed in designate before the DesignateZone, it is not deleted with the
+	// DesignateZone
+	Adopted bool `json:"adopted,omitempty"`
+
+	// Serial - serial of the zone, which designate increases on every change of the records
+	Serial int64 `json:"serial,omitempty"`
+
+	// ZoneStatus - status of the zone in designate, ACTIVE, PENDING or ERROR
+	ZoneStatus string `json:"zoneStatus,omitempty"`
+
+	// Action - action designate is processing on the zone, CREATE, UPDATE, DELETE or NONE
+	Action string `json:"action,omitempty"`
+
+	// Conditions
+	Conditions condition.Conditions `json:"conditions,omitempty" optional:"true"`
+
+	// ObservedGeneration - the generation of the spec which got fully reconciled. The conditions
+	// only describe the current spec if it matches metadata.generation
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:printcolumn:name="Zone",type="string",JSONPath=".spec.name",description="Zone name"
+// +kubebuilder:printcolumn:name="ID",type="string",JSONPath=".status.zoneID",description="Zone ID"
+// +kubebuilder:printcolumn:name="Serial",type="integer",JSONPath=".status.serial",description="Zone serial"
+// +kubebuilder:printcolumn:name="Zone Status",type="string",JSONPath=".status.zoneStatus",description="Zone status in designate"
+// +kubebuilder:printcolumn:name="Status",type="string",JSONPath=".status.conditions[0].status",description="Status"
+// +kubebuilder:printcolumn:name="Message",type="string",JSONPath=".status.conditions[0].message",description="Message"
+
+// DesignateZone is the Schema for the designatezones API
+type DesignateZone struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   DesignateZoneSpec   `json:"spec,omitempty"`
+	Status DesignateZoneStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// DesignateZoneList contains a list of DesignateZone
+type DesignateZoneList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DesignateZone `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&DesignateZone{}, &DesignateZoneList{})
+}
+
+// IsReady - returns true if the zone is active in designate
+func (instance DesignateZone) IsReady() bool {
+	return instance.Status.Conditions.IsTrue(condition.InputReadyCondition) &&
+		instance.Status.Conditions.IsTrue(DesignateZoneReadyCondition)
+}
@@ -68,6 +68,58 @@ func (in *DesignateAPIDebug) DeepCopy() *DesignateAPIDebug {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DesignateAPIDeletionPolicy) DeepCopyInto(out *DesignateAPIDeletionPolicy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DesignateAPIDeletionPolicy.
+func (in *DesignateAPIDeletionPolicy) DeepCopy() *DesignateAPIDeletionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(DesignateAPIDeletionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DesignateAPILogging) DeepCopyInto(out *DesignateAPILogging) {
+	*out = *in
+	if in.Loggers != nil {
+		in, out := &in.Loggers, &out.Loggers
+		*out = make(map[string]LogLevel, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DesignateAPILogging.
+func (in *DesignateAPILogging) DeepCopy() *DesignateAPILogging {
+	if in == nil {
+		return nil
+	}
+	out := new(DesignateAPILogging)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DesignateAPIMetrics) DeepCopyInto(out *DesignateAPIMetrics) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DesignateAPIMetrics.
+func (in *DesignateAPIMetrics) DeepCopy() *DesignateAPIMetrics {
+	if in == nil {
+		return nil
+	}
+	out := new(DesignateAPIMetrics)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DesignateAPIList) DeepCopyInto(out *DesignateAPIList) {
 	*out = *in
@@ -100,10 +152,56 @@ func (in *DesignateAPIList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DesignateAPINeutronDNS) DeepCopyInto(out *DesignateAPINeutronDNS) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DesignateAPINeutronDNS.
+func (in *DesignateAPINeutronDNS) DeepCopy() *DesignateAPINeutronDNS {
+	if in == nil {
+		return nil
+	}
+	out := new(DesignateAPINeutronDNS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DesignateAPIQuotas) DeepCopyInto(out *DesignateAPIQuotas) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DesignateAPIQuotas.
+func (in *DesignateAPIQuotas) DeepCopy() *DesignateAPIQuotas {
+	if in == nil {
+		return nil
+	}
+	out := new(DesignateAPIQuotas)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DesignateAPIServer) DeepCopyInto(out *DesignateAPIServer) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DesignateAPIServer.
+func (in *DesignateAPIServer) DeepCopy() *DesignateAPIServer {
+	if in == nil {
+		return nil
+	}
+	out := new(DesignateAPIServer)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DesignateAPISpec) DeepCopyInto(out *DesignateAPISpec) {
 	*out = *in
 	out.PasswordSelectors = in.PasswordSelectors
+	out.DeletionPolicy = in.DeletionPolicy
 	if in.NodeSelector != nil {
 		in, out := &in.NodeSelector, &out.NodeSelector
 		*out = make(map[string]string, len(*in))
@@ -119,6 +217,18 @@ func (in *DesignateAPISpec) DeepCopyInto(out *DesignateAPISpec) {
 			(*out)[key] = val
 		}
 	}
+	in.Logging.DeepCopyInto(&out.Logging)
+	out.Metrics = in.Metrics
+	out.NeutronDNS = in.NeutronDNS
+	out.Quotas = in.Quotas
+	out.APIServer = in.APIServer
+	if in.Policy != nil {
+		in, out := &in.Policy, &out.Policy
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	in.Resources.DeepCopyInto(&out.Resources)
 }
 
@@ -149,6 +259,13 @@ func (in *DesignateAPIStatus) DeepCopyInto(out *DesignateAPIStatus) {
 			(*out)[key] = val
 		}
 	}
+	if in.RegionEndpointIDs != nil {
+		in, out := &in.RegionEndpointIDs, &out.RegionEndpointIDs
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make(condition.Conditions, len(*in))
@@ -168,6 +285,842 @@ func (in *DesignateAPIStatus) DeepCopy() *DesignateAPIStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DesignateBlacklist) DeepCopyInto(out *DesignateBlacklist) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DesignateBlacklist.
+func (in *DesignateBlacklist) DeepCopy() *DesignateBlacklist {
+	if in == nil {
+		return nil
+	}
+	out := new(DesignateBlacklist)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DesignateBlacklist) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DesignateBlacklistList) DeepCopyInto(out *DesignateBlacklistList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DesignateBlacklist, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DesignateBlacklistList.
+func (in *DesignateBlacklistList) DeepCopy() *DesignateBlacklistList {
+	if in == nil {
+		return nil
+	}
+	out := new(DesignateBlacklistList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DesignateBlacklistList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DesignateBlacklistSpec) DeepCopyInto(out *DesignateBlacklistSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DesignateBlacklistSpec.
+func (in *DesignateBlacklistSpec) DeepCopy() *DesignateBlacklistSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DesignateBlacklistSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DesignateBlacklistStatus) DeepCopyInto(out *DesignateBlacklistStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(condition.Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DesignateBlacklistStatus.
+func (in *DesignateBlacklistStatus) DeepCopy() *DesignateBlacklistStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DesignateBlacklistStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DesignateQuota) DeepCopyInto(out *DesignateQuota) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DesignateQuota.
+func (in *DesignateQuota) DeepCopy() *DesignateQuota {
+	if in == nil {
+		return nil
+	}
+	out := new(DesignateQuota)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DesignateQuota) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DesignateQuotaList) DeepCopyInto(out *DesignateQuotaList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DesignateQuota, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DesignateQuotaList.
+func (in *DesignateQuotaList) DeepCopy() *DesignateQuotaList {
+	if in == nil {
+		return nil
+	}
+	out := new(DesignateQuotaList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DesignateQuotaList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DesignateQuotaSpec) DeepCopyInto(out *DesignateQuotaSpec) {
+	*out = *in
+	if in.Zones != nil {
+		in, out := &in.Zones, &out.Zones
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ZoneRecordsets != nil {
+		in, out := &in.ZoneRecordsets, &out.ZoneRecordsets
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ZoneRecords != nil {
+		in, out := &in.ZoneRecords, &out.ZoneRecords
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RecordsetRecords != nil {
+		in, out := &in.RecordsetRecords, &out.RecordsetRecords
+		*out = new(int32)
+		**out = **in
+	}
+	if in.APIExportSize != nil {
+		in, out := &in.APIExportSize, &out.APIExportSize
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DesignateQuotaSpec.
+func (in *DesignateQuotaSpec) DeepCopy() *DesignateQuotaSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DesignateQuotaSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DesignateQuotaStatus) DeepCopyInto(out *DesignateQuotaStatus) {
+	*out = *in
+	if in.Quotas != nil {
+		in, out := &in.Quotas, &out.Quotas
+		*out = new(DesignateQuotaValues)
+		**out = **in
+	}
+	if in.Usage != nil {
+		in, out := &in.Usage, &out.Usage
+		*out = new(DesignateQuotaUsage)
+		**out = **in
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(condition.Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DesignateQuotaStatus.
+func (in *DesignateQuotaStatus) DeepCopy() *DesignateQuotaStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DesignateQuotaStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DesignateQuotaUsage) DeepCopyInto(out *DesignateQuotaUsage) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DesignateQuotaUsage.
+func (in *DesignateQuotaUsage) DeepCopy() *DesignateQuotaUsage {
+	if in == nil {
+		return nil
+	}
+	out := new(DesignateQuotaUsage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DesignateQuotaValues) DeepCopyInto(out *DesignateQuotaValues) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DesignateQuotaValues.
+func (in *DesignateQuotaValues) DeepCopy() *DesignateQuotaValues {
+	if in == nil {
+		return nil
+	}
+	out := new(DesignateQuotaValues)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DesignateRecordSet) DeepCopyInto(out *DesignateRecordSet) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DesignateRecordSet.
+func (in *DesignateRecordSet) DeepCopy() *DesignateRecordSet {
+	if in == nil {
+		return nil
+	}
+	out := new(DesignateRecordSet)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DesignateRecordSet) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DesignateRecordSetList) DeepCopyInto(out *DesignateRecordSetList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DesignateRecordSet, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DesignateRecordSetList.
+func (in *DesignateRecordSetList) DeepCopy() *DesignateRecordSetList {
+	if in == nil {
+		return nil
+	}
+	out := new(DesignateRecordSetList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DesignateRecordSetList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DesignateRecordSetSpec) DeepCopyInto(out *DesignateRecordSetSpec) {
+	*out = *in
+	if in.Records != nil {
+		in, out := &in.Records, &out.Records
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DesignateRecordSetSpec.
+func (in *DesignateRecordSetSpec) DeepCopy() *DesignateRecordSetSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DesignateRecordSetSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DesignateRecordSetStatus) DeepCopyInto(out *DesignateRecordSetStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(condition.Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DesignateRecordSetStatus.
+func (in *DesignateRecordSetStatus) DeepCopy() *DesignateRecordSetStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DesignateRecordSetStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DesignateSink) DeepCopyInto(out *DesignateSink) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DesignateSink.
+func (in *DesignateSink) DeepCopy() *DesignateSink {
+	if in == nil {
+		return nil
+	}
+	out := new(DesignateSink)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DesignateSink) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DesignateSinkDebug) DeepCopyInto(out *DesignateSinkDebug) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DesignateSinkDebug.
+func (in *DesignateSinkDebug) DeepCopy() *DesignateSinkDebug {
+	if in == nil {
+		return nil
+	}
+	out := new(DesignateSinkDebug)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DesignateSinkHandler) DeepCopyInto(out *DesignateSinkHandler) {
+	*out = *in
+	if in.FormatV4 != nil {
+		in, out := &in.FormatV4, &out.FormatV4
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.FormatV6 != nil {
+		in, out := &in.FormatV6, &out.FormatV6
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DesignateSinkHandler.
+func (in *DesignateSinkHandler) DeepCopy() *DesignateSinkHandler {
+	if in == nil {
+		return nil
+	}
+	out := new(DesignateSinkHandler)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DesignateSinkHandlers) DeepCopyInto(out *DesignateSinkHandlers) {
+	*out = *in
+	if in.NovaFixed != nil {
+		in, out := &in.NovaFixed, &out.NovaFixed
+		*out = new(DesignateSinkHandler)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NeutronFloatingIP != nil {
+		in, out := &in.NeutronFloatingIP, &out.NeutronFloatingIP
+		*out = new(DesignateSinkHandler)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DesignateSinkHandlers.
+func (in *DesignateSinkHandlers) DeepCopy() *DesignateSinkHandlers {
+	if in == nil {
+		return nil
+	}
+	out := new(DesignateSinkHandlers)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DesignateSinkList) DeepCopyInto(out *DesignateSinkList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DesignateSink, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DesignateSinkList.
+func (in *DesignateSinkList) DeepCopy() *DesignateSinkList {
+	if in == nil {
+		return nil
+	}
+	out := new(DesignateSinkList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DesignateSinkList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DesignateSinkSpec) DeepCopyInto(out *DesignateSinkSpec) {
+	*out = *in
+	in.Handlers.DeepCopyInto(&out.Handlers)
+	if in.NodeSelector != nil {
+		in, out := &in.NodeSelector, &out.NodeSelector
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	out.Debug = in.Debug
+	if in.DefaultConfigOverwrite != nil {
+		in, out := &in.DefaultConfigOverwrite, &out.DefaultConfigOverwrite
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	in.Resources.DeepCopyInto(&out.Resources)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DesignateSinkSpec.
+func (in *DesignateSinkSpec) DeepCopy() *DesignateSinkSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DesignateSinkSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DesignateSinkStatus) DeepCopyInto(out *DesignateSinkStatus) {
+	*out = *in
+	if in.Hash != nil {
+		in, out := &in.Hash, &out.Hash
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(condition.Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DesignateSinkStatus.
+func (in *DesignateSinkStatus) DeepCopy() *DesignateSinkStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DesignateSinkStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DesignateTLD) DeepCopyInto(out *DesignateTLD) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DesignateTLD.
+func (in *DesignateTLD) DeepCopy() *DesignateTLD {
+	if in == nil {
+		return nil
+	}
+	out := new(DesignateTLD)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DesignateTLD) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DesignateTLDList) DeepCopyInto(out *DesignateTLDList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DesignateTLD, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DesignateTLDList.
+func (in *DesignateTLDList) DeepCopy() *DesignateTLDList {
+	if in == nil {
+		return nil
+	}
+	out := new(DesignateTLDList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DesignateTLDList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DesignateTLDSpec) DeepCopyInto(out *DesignateTLDSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DesignateTLDSpec.
+func (in *DesignateTLDSpec) DeepCopy() *DesignateTLDSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DesignateTLDSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DesignateTLDStatus) DeepCopyInto(out *DesignateTLDStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(condition.Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DesignateTLDStatus.
+func (in *DesignateTLDStatus) DeepCopy() *DesignateTLDStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DesignateTLDStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DesignateTsigKey) DeepCopyInto(out *DesignateTsigKey) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DesignateTsigKey.
+func (in *DesignateTsigKey) DeepCopy() *DesignateTsigKey {
+	if in == nil {
+		return nil
+	}
+	out := new(DesignateTsigKey)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DesignateTsigKey) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DesignateTsigKeyList) DeepCopyInto(out *DesignateTsigKeyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DesignateTsigKey, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DesignateTsigKeyList.
+func (in *DesignateTsigKeyList) DeepCopy() *DesignateTsigKeyList {
+	if in == nil {
+		return nil
+	}
+	out := new(DesignateTsigKeyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DesignateTsigKeyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DesignateTsigKeySpec) DeepCopyInto(out *DesignateTsigKeySpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DesignateTsigKeySpec.
+func (in *DesignateTsigKeySpec) DeepCopy() *DesignateTsigKeySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DesignateTsigKeySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DesignateTsigKeyStatus) DeepCopyInto(out *DesignateTsigKeyStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(condition.Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DesignateTsigKeyStatus.
+func (in *DesignateTsigKeyStatus) DeepCopy() *DesignateTsigKeyStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DesignateTsigKeyStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DesignateZone) DeepCopyInto(out *DesignateZone) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DesignateZone.
+func (in *DesignateZone) DeepCopy() *DesignateZone {
+	if in == nil {
+		return nil
+	}
+	out := new(DesignateZone)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DesignateZone) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DesignateZoneList) DeepCopyInto(out *DesignateZoneList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DesignateZone, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DesignateZoneList.
+func (in *DesignateZoneList) DeepCopy() *DesignateZoneList {
+	if in == nil {
+		return nil
+	}
+	out := new(DesignateZoneList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DesignateZoneList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DesignateZoneSpec) DeepCopyInto(out *DesignateZoneSpec) {
+	*out = *in
+	if in.Masters != nil {
+		in, out := &in.Masters, &out.Masters
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DesignateZoneSpec.
+func (in *DesignateZoneSpec) DeepCopy() *DesignateZoneSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DesignateZoneSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DesignateZoneStatus) DeepCopyInto(out *DesignateZoneStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make(condition.Conditions, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DesignateZoneStatus.
+func (in *DesignateZoneStatus) DeepCopy() *DesignateZoneStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DesignateZoneStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PasswordSelector) DeepCopyInto(out *PasswordSelector) {
 	*out = *in
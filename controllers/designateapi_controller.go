@@ -20,6 +20,7 @@ package controllers
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -37,6 +38,7 @@ import (
 	"github.com/openstack-k8s-operators/lib-common/modules/common/job"
 	"github.com/openstack-k8s-operators/lib-common/modules/common/labels"
 	oko_secret "github.com/openstack-k8s-operators/lib-common/modules/common/secret"
+	"github.com/openstack-k8s-operators/lib-common/modules/common/service"
 	"github.com/openstack-k8s-operators/lib-common/modules/common/util"
 	"github.com/openstack-k8s-operators/lib-common/modules/database"
 	mariadbv1 "github.com/openstack-k8s-operators/mariadb-operator/api/v1beta1"
@@ -44,20 +46,33 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	k8s_errors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/record"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 )
 
+// secretField - index of the DesignateAPIs by the name of the referenced OpenStack secret
+const secretField = ".spec.secret"
+
 // DesignateAPIReconciler reconciles a DesignateAPI object
 type DesignateAPIReconciler struct {
 	client.Client
-	Kclient kubernetes.Interface
-	Log     logr.Logger
-	Scheme  *runtime.Scheme
+	Kclient  kubernetes.Interface
+	Log      logr.Logger
+	Scheme   *runtime.Scheme
+	Recorder record.EventRecorder
 }
 
 // +kubebuilder:rbac:groups=designate.openstack.org,resources=designateapis,verbs=get;list;watch;create;update;patch;delete
@@ -73,6 +88,8 @@ type DesignateAPIReconciler struct {
 // +kubebuilder:rbac:groups=keystone.openstack.org,resources=keystoneapis,verbs=get;list;watch;
 // +kubebuilder:rbac:groups=keystone.openstack.org,resources=keystoneservices,verbs=get;list;watch;create;update;patch;delete;
 // +kubebuilder:rbac:groups=keystone.openstack.org,resources=keystoneendpoints,verbs=get;list;watch;create;update;patch;delete;
+// +kubebuilder:rbac:groups=core,resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups=monitoring.coreos.com,resources=servicemonitors,verbs=get;list;watch;create;update;patch;delete;
 
 // Reconcile is part of the main kubernetes reconciliation loop which aims to
 // move the current state of the cluster closer to the desired state.
@@ -100,6 +117,9 @@ func (r *DesignateAPIReconciler) Reconcile(ctx context.Context, req ctrl.Request
 		return ctrl.Result{}, err
 	}
 
+	// conditions as persisted by the previous reconcile, used to emit events on state changes
+	savedConditions := instance.Status.Conditions.DeepCopy()
+
 	helper, err := helper.NewHelper(
 		instance,
 		r.Client,
@@ -113,16 +133,32 @@ func (r *DesignateAPIReconciler) Reconcile(ctx context.Context, req ctrl.Request
 
 	// Always patch the instance status when exiting this function so we can persist any changes.
 	defer func() {
-		// update the overall status condition if service is ready
-		if instance.IsReady() {
+		// update the overall status condition if service is ready and the current spec got reconciled
+		if instance.IsReady() && instance.Status.ObservedGeneration == instance.Generation {
 			instance.Status.Conditions.MarkTrue(condition.ReadyCondition, condition.ReadyMessage)
+		} else if !instance.IsReady() && instance.Status.Conditions.IsTrue(condition.ReadyCondition) {
+			// the service became unready after it was ready, e.g. because pods went down
+			instance.Status.Conditions.MarkFalse(
+				condition.ReadyCondition,
+				condition.RequestedReason,
+				condition.SeverityWarning,
+				designatev1.DesignateAPINotReadyMessage)
 		}
+		instance.Status.Phase = instance.GetPhase()
 
 		err := helper.PatchInstance(ctx, instance)
 		if err != nil {
 			_err = err
 			return
 		}
+
+		r.recordConditionEvents(instance, savedConditions)
+
+		if !instance.DeletionTimestamp.IsZero() && !controllerutil.ContainsFinalizer(instance, helper.GetFinalizer()) {
+			deleteInstanceMetrics(instance)
+		} else {
+			recordInstanceMetrics(instance)
+		}
 	}()
 
 	// If we're not deleting this and the service object doesn't have our finalizer, add it.
@@ -143,6 +179,9 @@ func (r *DesignateAPIReconciler) Reconcile(ctx context.Context, req ctrl.Request
 			condition.UnknownCondition(condition.InputReadyCondition, condition.InitReason, condition.InputReadyInitMessage),
 			condition.UnknownCondition(condition.ServiceConfigReadyCondition, condition.InitReason, condition.ServiceConfigReadyInitMessage),
 			condition.UnknownCondition(condition.DeploymentReadyCondition, condition.InitReason, condition.DeploymentReadyInitMessage),
+			condition.UnknownCondition(designatev1.DesignateAPIAvailableCondition, condition.InitReason, designatev1.DesignateAPIAvailableInitMessage),
+			condition.UnknownCondition(designatev1.DesignateAPIProgressingCondition, condition.InitReason, designatev1.DesignateAPIProgressingInitMessage),
+			condition.UnknownCondition(designatev1.DesignateAPIDegradedCondition, condition.InitReason, designatev1.DesignateAPIDegradedInitMessage),
 			// right now we have no dedicated KeystoneServiceReadyInitMessage
 			condition.UnknownCondition(condition.KeystoneServiceReadyCondition, condition.InitReason, ""),
 			condition.UnknownCondition(condition.KeystoneEndpointReadyCondition, condition.InitReason, ""),
@@ -153,6 +192,10 @@ func (r *DesignateAPIReconciler) Reconcile(ctx context.Context, req ctrl.Request
 		// Register overall status immediately to have an early feedback e.g. in the cli
 		return ctrl.Result{}, nil
 	}
+	// Ready refers to the observed generation, reset it until the new spec got reconciled
+	if instance.Status.ObservedGeneration != instance.Generation && !instance.Status.Conditions.IsUnknown(condition.ReadyCondition) {
+		instance.Status.Conditions.MarkUnknown(condition.ReadyCondition, condition.InitReason, condition.ReadyInitMessage)
+	}
 	if instance.Status.Hash == nil {
 		instance.Status.Hash = map[string]string{}
 	}
@@ -171,6 +214,37 @@ func (r *DesignateAPIReconciler) Reconcile(ctx context.Context, req ctrl.Request
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *DesignateAPIReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// index the referenced OpenStack secret to find the DesignateAPIs to reconcile on secret changes
+	err := mgr.GetFieldIndexer().IndexField(
+		context.Background(),
+		&designatev1.DesignateAPI{},
+		secretField,
+		func(rawObj client.Object) []string {
+			instance := rawObj.(*designatev1.DesignateAPI)
+			if instance.Spec.Secret == "" {
+				return nil
+			}
+			return []string{instance.Spec.Secret}
+		})
+	if err != nil {
+		return err
+	}
+	// index the referenced transport URL secret, which gets watched the same way
+	err = mgr.GetFieldIndexer().IndexField(
+		context.Background(),
+		&designatev1.DesignateAPI{},
+		transportURLSecretField,
+		func(rawObj client.Object) []string {
+			instance := rawObj.(*designatev1.DesignateAPI)
+			if instance.Spec.TransportURLSecret == "" {
+				return nil
+			}
+			return []string{instance.Spec.TransportURLSecret}
+		})
+	if err != nil {
+		return err
+	}
+
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&designatev1.DesignateAPI{}).
 		Owns(&mariadbv1.MariaDBDatabase{}).
@@ -182,64 +256,310 @@ func (r *DesignateAPIReconciler) SetupWithManager(mgr ctrl.Manager) error {
 		Owns(&corev1.ConfigMap{}).
 		Owns(&appsv1.Deployment{}).
 		Owns(&routev1.Route{}).
+		Watches(
+			&source.Kind{Type: &corev1.Secret{}},
+			handler.EnqueueRequestsFromMapFunc(r.findObjectsForSecret),
+		).
+		Watches(
+			&source.Kind{Type: &keystonev1.KeystoneAPI{}},
+			handler.EnqueueRequestsFromMapFunc(r.findObjectsForKeystoneAPI),
+		).
 		Complete(r)
 }
 
+// findObjectsForSecret - returns a reconcile request for every DesignateAPI referencing the secret
+// as OpenStack secret or as transport URL secret
+func (r *DesignateAPIReconciler) findObjectsForSecret(secret client.Object) []reconcile.Request {
+	requests := []reconcile.Request{}
+	for _, field := range []string{secretField, transportURLSecretField} {
+		instances := &designatev1.DesignateAPIList{}
+		err := r.Client.List(
+			context.Background(),
+			instances,
+			client.InNamespace(secret.GetNamespace()),
+			client.MatchingFields{field: secret.GetName()},
+		)
+		if err != nil {
+			r.Log.Error(err, fmt.Sprintf("Unable to list DesignateAPIs referencing Secret %s", secret.GetName()))
+			return []reconcile.Request{}
+		}
+		// the work queue drops the duplicate request of a DesignateAPI referencing the secret twice
+		requests = append(requests, reconcileRequests(instances)...)
+	}
+
+	return requests
+}
+
+// findObjectsForKeystoneAPI - returns a reconcile request for every DesignateAPI in the namespace of the
+// KeystoneAPI, as the DesignateAPIs use the KeystoneAPI of their namespace
+func (r *DesignateAPIReconciler) findObjectsForKeystoneAPI(keystoneAPI client.Object) []reconcile.Request {
+	instances := &designatev1.DesignateAPIList{}
+	err := r.Client.List(
+		context.Background(),
+		instances,
+		client.InNamespace(keystoneAPI.GetNamespace()),
+	)
+	if err != nil {
+		r.Log.Error(err, fmt.Sprintf("Unable to list DesignateAPIs for KeystoneAPI %s", keystoneAPI.GetName()))
+		return []reconcile.Request{}
+	}
+
+	return reconcileRequests(instances)
+}
+
+func reconcileRequests(instances *designatev1.DesignateAPIList) []reconcile.Request {
+	requests := make([]reconcile.Request, len(instances.Items))
+	for i, instance := range instances.Items {
+		requests[i] = reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      instance.GetName(),
+				Namespace: instance.GetNamespace(),
+			},
+		}
+	}
+	return requests
+}
+
 func (r *DesignateAPIReconciler) reconcileDelete(ctx context.Context, instance *designatev1.DesignateAPI, helper *helper.Helper) (ctrl.Result, error) {
 	util.LogForObject(helper, "Reconciling Service delete", instance)
 
-	// remove db finalizer first
+	// delete or retain the DB first
+	ctrlResult, err := r.deleteDatabase(ctx, instance, helper)
+	if err != nil {
+		return ctrlResult, err
+	} else if (ctrlResult != ctrl.Result{}) {
+		return ctrlResult, nil
+	}
+
+	// It's possible to get here before the endpoints have been set in the status, so check for this
+	if instance.Status.APIEndpoints != nil {
+		ctrlResult, err = r.deleteKeystoneRegistration(ctx, instance, helper)
+		if err != nil {
+			return ctrlResult, err
+		} else if (ctrlResult != ctrl.Result{}) {
+			return ctrlResult, nil
+		}
+	}
+
+	// We did all the cleanup on the objects we created so we can remove the
+	// finalizer from ourselves to allow the deletion
+	controllerutil.RemoveFinalizer(instance, helper.GetFinalizer())
+	r.Log.Info(fmt.Sprintf("Reconciled Service '%s' delete successfully", instance.Name))
+
+	util.LogForObject(helper, "Reconciled Service delete successfully", instance)
+	return ctrl.Result{}, nil
+}
+
+// deleteDatabase - deletes the MariaDBDatabase, which drops the DB schema and user, or detaches it
+// from the instance depending on the database deletion policy
+func (r *DesignateAPIReconciler) deleteDatabase(
+	ctx context.Context,
+	instance *designatev1.DesignateAPI,
+	helper *helper.Helper,
+) (ctrl.Result, error) {
 	db, err := database.GetDatabaseByName(ctx, helper, instance.Name)
-	if err != nil && !k8s_errors.IsNotFound(err) {
+	if err != nil {
+		if k8s_errors.IsNotFound(err) {
+			if !instance.Status.Conditions.IsFalse(condition.DBReadyCondition) ||
+				instance.Status.Conditions.Get(condition.DBReadyCondition).Reason != condition.DeletedReason {
+				instance.Status.Conditions.Set(condition.FalseCondition(
+					condition.DBReadyCondition,
+					condition.DeletedReason,
+					condition.SeverityInfo,
+					"Database %s deleted", instance.Name))
+			}
+			return ctrl.Result{}, nil
+		}
 		return ctrl.Result{}, err
 	}
+	mariaDBDatabase := db.GetDatabase()
 
-	if !k8s_errors.IsNotFound(err) {
-		if err := db.DeleteFinalizer(ctx, helper); err != nil {
+	if instance.Spec.DeletionPolicy.Database == designatev1.DeletionPolicyRetain {
+		// detach the MariaDBDatabase so that it does not get garbage collected with the instance
+		controllerutil.RemoveFinalizer(mariaDBDatabase, helper.GetFinalizer())
+		removeOwnerReferences(instance, mariaDBDatabase)
+		if err := helper.GetClient().Update(ctx, mariaDBDatabase); err != nil && !k8s_errors.IsNotFound(err) {
 			return ctrl.Result{}, err
 		}
+		instance.Status.Conditions.Set(condition.FalseCondition(
+			condition.DBReadyCondition,
+			condition.DeletedReason,
+			condition.SeverityInfo,
+			"Database %s retained", instance.Name))
+		util.LogForObject(helper, fmt.Sprintf("Retained MariaDBDatabase %s", mariaDBDatabase.Name), instance)
+		return ctrl.Result{}, nil
 	}
 
-	// It's possible to get here before the endpoints have been set in the status, so check for this
-	if instance.Status.APIEndpoints != nil {
-		// Remove the finalizer from our KeystoneEndpoint CR
-		keystoneEndpoint, err := keystonev1.GetKeystoneEndpointWithName(ctx, helper, designate.ServiceName, instance.Namespace)
-		if err != nil && !k8s_errors.IsNotFound(err) {
+	// the mariadb-operator drops the DB schema and user when the MariaDBDatabase gets deleted
+	if controllerutil.ContainsFinalizer(mariaDBDatabase, helper.GetFinalizer()) {
+		if err := db.DeleteFinalizer(ctx, helper); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+	if mariaDBDatabase.DeletionTimestamp.IsZero() {
+		if err := helper.GetClient().Delete(ctx, mariaDBDatabase); err != nil && !k8s_errors.IsNotFound(err) {
+			instance.Status.Conditions.Set(condition.FalseCondition(
+				condition.DBReadyCondition,
+				condition.DeletionFailedReason,
+				condition.SeverityWarning,
+				"Error deleting database %s: %s", instance.Name, err.Error()))
 			return ctrl.Result{}, err
 		}
+		util.LogForObject(helper, fmt.Sprintf("Deleting MariaDBDatabase %s", mariaDBDatabase.Name), instance)
+	}
+	instance.Status.Conditions.Set(condition.FalseCondition(
+		condition.DBReadyCondition,
+		condition.DeletingReason,
+		condition.SeverityInfo,
+		"Deleting database %s", instance.Name))
+
+	// wait for the MariaDBDatabase to be gone
+	return ctrl.Result{RequeueAfter: time.Duration(5) * time.Second}, nil
+}
 
-		if err == nil {
-			controllerutil.RemoveFinalizer(keystoneEndpoint, helper.GetFinalizer())
-			if err = helper.GetClient().Update(ctx, keystoneEndpoint); err != nil && !k8s_errors.IsNotFound(err) {
-				return ctrl.Result{}, err
-			}
-			util.LogForObject(helper, "Removed finalizer from our KeystoneEndpoint", instance)
+// deleteKeystoneRegistration - deletes the KeystoneEndpoint, the KeystoneService and the region endpoints
+// or detaches them from the instance depending on the keystone deletion policy
+func (r *DesignateAPIReconciler) deleteKeystoneRegistration(
+	ctx context.Context,
+	instance *designatev1.DesignateAPI,
+	helper *helper.Helper,
+) (ctrl.Result, error) {
+	retain := instance.Spec.DeletionPolicy.Keystone == designatev1.DeletionPolicyRetain
+
+	// Remove the endpoints registered in a region other than the region of the KeystoneAPI
+	keystoneAPI, err := keystonev1.GetKeystoneAPI(ctx, helper, instance.Namespace, map[string]string{})
+	if err != nil && !k8s_errors.IsNotFound(err) {
+		return ctrl.Result{}, err
+	}
+
+	if !retain && err == nil && len(instance.Status.RegionEndpointIDs) > 0 {
+		ctrlResult, err := r.deleteRegionEndpoints(ctx, instance, helper, keystoneAPI)
+		if err != nil || (ctrlResult != ctrl.Result{}) {
+			return ctrlResult, err
 		}
+		instance.Status.Region = ""
+	}
 
-		// Remove the finalizer from our KeystoneService CR
-		keystoneService, err := keystonev1.GetKeystoneServiceWithName(ctx, helper, designate.ServiceName, instance.Namespace)
-		if err != nil && !k8s_errors.IsNotFound(err) {
+	// KeystoneEndpoint
+	keystoneEndpoint, err := keystonev1.GetKeystoneEndpointWithName(ctx, helper, instance.Name, instance.Namespace)
+	if err != nil && !k8s_errors.IsNotFound(err) {
+		return ctrl.Result{}, err
+	}
+
+	if err == nil {
+		ctrlResult, err := r.deleteOrRetain(ctx, instance, helper, keystoneEndpoint, "KeystoneEndpoint", retain, condition.KeystoneEndpointReadyCondition)
+		if err != nil {
+			return ctrlResult, err
+		} else if (ctrlResult != ctrl.Result{}) {
+			return ctrlResult, nil
+		}
+	}
+
+	// KeystoneService, if this instance registered it
+	keystoneService, err := keystonev1.GetKeystoneServiceWithName(ctx, helper, designate.ServiceName, instance.Namespace)
+	if err != nil && !k8s_errors.IsNotFound(err) {
+		return ctrl.Result{}, err
+	}
+
+	if err == nil && metav1.IsControlledBy(keystoneService, instance) {
+		// other DesignateAPIs still use the keystone service, hand it over instead of deleting it
+		designateAPIs := &designatev1.DesignateAPIList{}
+		err = helper.GetClient().List(ctx, designateAPIs, client.InNamespace(instance.Namespace))
+		if err != nil {
 			return ctrl.Result{}, err
 		}
+		for i := range designateAPIs.Items {
+			newOwner := &designateAPIs.Items[i]
+			if newOwner.UID == instance.UID || !newOwner.DeletionTimestamp.IsZero() {
+				continue
+			}
 
-		if err == nil {
-			controllerutil.RemoveFinalizer(keystoneService, helper.GetFinalizer())
+			removeOwnerReferences(instance, keystoneService)
+			err = controllerutil.SetControllerReference(newOwner, keystoneService, helper.GetScheme())
+			if err != nil {
+				return ctrl.Result{}, err
+			}
 			if err = helper.GetClient().Update(ctx, keystoneService); err != nil && !k8s_errors.IsNotFound(err) {
 				return ctrl.Result{}, err
 			}
-			util.LogForObject(helper, "Removed finalizer from our KeystoneService", instance)
+			instance.Status.Conditions.Set(condition.FalseCondition(
+				condition.KeystoneServiceReadyCondition,
+				condition.DeletedReason,
+				condition.SeverityInfo,
+				"KeystoneService %s handed over to %s", keystoneService.Name, newOwner.Name))
+			util.LogForObject(helper, fmt.Sprintf("Handed over KeystoneService %s to %s", keystoneService.Name, newOwner.Name), instance)
+
+			return ctrl.Result{}, nil
 		}
-	}
 
-	// We did all the cleanup on the objects we created so we can remove the
-	// finalizer from ourselves to allow the deletion
-	controllerutil.RemoveFinalizer(instance, helper.GetFinalizer())
-	r.Log.Info(fmt.Sprintf("Reconciled Service '%s' delete successfully", instance.Name))
+		return r.deleteOrRetain(ctx, instance, helper, keystoneService, "KeystoneService", retain, condition.KeystoneServiceReadyCondition)
+	}
 
-	util.LogForObject(helper, "Reconciled Service delete successfully", instance)
 	return ctrl.Result{}, nil
 }
 
+// deleteOrRetain - removes the finalizer of the instance from obj and either deletes obj, in which case the
+// reconcile requeues until obj is gone, or removes the owner references of the instance to retain it.
+// The cleanup step gets reported via the condition of type t.
+func (r *DesignateAPIReconciler) deleteOrRetain(
+	ctx context.Context,
+	instance *designatev1.DesignateAPI,
+	helper *helper.Helper,
+	obj client.Object,
+	kind string,
+	retain bool,
+	t condition.Type,
+) (ctrl.Result, error) {
+	controllerutil.RemoveFinalizer(obj, helper.GetFinalizer())
+	if retain {
+		removeOwnerReferences(instance, obj)
+	}
+	if err := helper.GetClient().Update(ctx, obj); err != nil && !k8s_errors.IsNotFound(err) {
+		return ctrl.Result{}, err
+	}
+
+	if retain {
+		instance.Status.Conditions.Set(condition.FalseCondition(
+			t,
+			condition.DeletedReason,
+			condition.SeverityInfo,
+			"%s %s retained", kind, obj.GetName()))
+		util.LogForObject(helper, fmt.Sprintf("Retained %s %s", kind, obj.GetName()), instance)
+		return ctrl.Result{}, nil
+	}
+
+	if obj.GetDeletionTimestamp().IsZero() {
+		if err := helper.GetClient().Delete(ctx, obj); err != nil && !k8s_errors.IsNotFound(err) {
+			instance.Status.Conditions.Set(condition.FalseCondition(
+				t,
+				condition.DeletionFailedReason,
+				condition.SeverityWarning,
+				"Error deleting %s %s: %s", kind, obj.GetName(), err.Error()))
+			return ctrl.Result{}, err
+		}
+		util.LogForObject(helper, fmt.Sprintf("Deleting %s %s", kind, obj.GetName()), instance)
+	}
+	instance.Status.Conditions.Set(condition.FalseCondition(
+		t,
+		condition.DeletingReason,
+		condition.SeverityInfo,
+		"Deleting %s %s", kind, obj.GetName()))
+
+	// wait for the keystone-operator to remove the registration
+	return ctrl.Result{RequeueAfter: time.Duration(5) * time.Second}, nil
+}
+
+// removeOwnerReferences - removes all owner references to the instance from obj
+func removeOwnerReferences(instance metav1.Object, obj metav1.Object) {
+	ownerRefs := []metav1.OwnerReference{}
+	for _, ref := range obj.GetOwnerReferences() {
+		if ref.UID != instance.GetUID() {
+			ownerRefs = append(ownerRefs, ref)
+		}
+	}
+	obj.SetOwnerReferences(ownerRefs)
+}
+
 func (r *DesignateAPIReconciler) reconcileInit(
 	ctx context.Context,
 	instance *designatev1.DesignateAPI,
@@ -301,9 +621,13 @@ func (r *DesignateAPIReconciler) reconcileInit(
 			condition.DBReadyRunningMessage))
 		return ctrlResult, nil
 	}
-	// update Status.DatabaseHostname, used to bootstrap/config the service
-	instance.Status.DatabaseHostname = db.GetDatabaseHostname()
 	instance.Status.Conditions.MarkTrue(condition.DBReadyCondition, condition.DBReadyMessage)
+	// update Status.DatabaseHostname, used to render the service config.
+	// Requeue to get the config rendered with the new hostname before running db sync.
+	if instance.Status.DatabaseHostname != db.GetDatabaseHostname() {
+		instance.Status.DatabaseHostname = db.GetDatabaseHostname()
+		return ctrl.Result{Requeue: true}, nil
+	}
 
 	// create service DB - end
 
@@ -339,19 +663,23 @@ func (r *DesignateAPIReconciler) reconcileInit(
 			condition.SeverityWarning,
 			condition.DBSyncReadyErrorMessage,
 			err.Error()))
+		recordDBSyncResult(instance, false, 0)
 		return ctrl.Result{}, err
 	}
 	if dbSyncjob.HasChanged() {
 		instance.Status.Hash[designatev1.DbSyncHash] = dbSyncjob.GetHash()
+
+		// the job is kept until its TTL expired, so the duration can be taken from its status
+		var duration time.Duration
+		dbSyncJobStatus, err := job.GetJobWithName(ctx, helper, jobDef.Name, jobDef.Namespace)
+		if err == nil && dbSyncJobStatus.Status.StartTime != nil && dbSyncJobStatus.Status.CompletionTime != nil {
+			duration = dbSyncJobStatus.Status.CompletionTime.Sub(dbSyncJobStatus.Status.StartTime.Time)
+		}
+		recordDBSyncResult(instance, true, duration)
 	}
 	instance.Status.Conditions.MarkTrue(condition.DBSyncReadyCondition, condition.DBSyncReadyMessage)
 
 	// run designate db sync - end
-	ctrlResult, err = r.registerInKeystone(ctx, instance, helper, serviceLabels)
-	if err != nil {
-		r.Log.Error(err, "registerInKeystone call failed", "ctrlResult",
-			ctrlResult)
-	}
 
 	//
 	// expose the service (create service, route and return the created endpoint URLs)
@@ -371,7 +699,7 @@ func (r *DesignateAPIReconciler) reconcileInit(
 	apiEndpoints, ctrlResult, err := endpoint.ExposeEndpoints(
 		ctx,
 		helper,
-		designate.ServiceName,
+		instance.Name,
 		serviceLabels,
 		designatePorts,
 		time.Duration(5)*time.Second,
@@ -405,6 +733,19 @@ func (r *DesignateAPIReconciler) reconcileInit(
 
 	// expose service - end
 
+	//
+	// register the service and the API endpoints from the status in keystone
+	//
+	ctrlResult, err = r.registerInKeystone(ctx, instance, helper, serviceLabels)
+	if err != nil {
+		keystoneRegistrationFailures.WithLabelValues(instance.Namespace, instance.Name).Inc()
+		return ctrlResult, err
+	} else if (ctrlResult != ctrl.Result{}) {
+		return ctrlResult, nil
+	}
+
+	// register in keystone - end
+
 	r.Log.Info("Reconciled Service init successfully")
 	return ctrl.Result{}, nil
 }
@@ -414,97 +755,345 @@ func (r *DesignateAPIReconciler) registerInKeystone(
 	instance *designatev1.DesignateAPI,
 	helper *helper.Helper,
 	serviceLabels map[string]string) (ctrl.Result, error) {
+	keystoneAPI, err := keystonev1.GetKeystoneAPI(ctx, helper, instance.Namespace, map[string]string{})
+	if err != nil {
+		instance.Status.Conditions.Set(condition.FalseCondition(
+			condition.KeystoneServiceReadyCondition,
+			condition.ErrorReason,
+			condition.SeverityWarning,
+			"Error getting the KeystoneAPI: %s",
+			err.Error()))
+		return ctrl.Result{}, err
+	}
+	region := instance.GetRegion(keystoneAPI.Spec.Region)
+
 	//
-	// create service and user in keystone - https://docs.openstack.org/designate/latest/install/install-ubuntu.html#prerequisites
+	// The designate service is registered once in keystone, so the KeystoneService is shared by all
+	// DesignateAPI instances of the namespace. Only the instance which created it registers the service.
 	//
-	ksSvcSpec := keystonev1.KeystoneServiceSpec{
-		ServiceType:        designate.ServiceType,
-		ServiceName:        designate.ServiceName,
-		ServiceDescription: "Designate Service",
-		Enabled:            true,
-		ServiceUser:        instance.Spec.ServiceUser,
-		Secret:             instance.Spec.Secret,
-		PasswordSelector:   instance.Spec.PasswordSelectors.Service,
-	}
-	ksSvc := keystonev1.NewKeystoneService(ksSvcSpec, instance.Namespace, serviceLabels, time.Duration(10)*time.Second)
-	ctrlResult, err := ksSvc.CreateOrPatch(ctx, helper)
-	if err != nil {
-		return ctrlResult, err
+	serviceOwner := ""
+	sharedKsSvc, err := keystonev1.GetKeystoneServiceWithName(ctx, helper, designate.ServiceName, instance.Namespace)
+	if err != nil && !k8s_errors.IsNotFound(err) {
+		return ctrl.Result{}, err
 	}
-	// mirror the Status, Reason, Severity and Message of the latest keystoneservice condition
-	// into a local condition with the type condition.KeystoneServiceReadyCondition
-	c := ksSvc.GetConditions().Mirror(condition.KeystoneServiceReadyCondition)
-	if c != nil {
-		instance.Status.Conditions.Set(c)
+	if err == nil && !metav1.IsControlledBy(sharedKsSvc, instance) {
+		owner := metav1.GetControllerOf(sharedKsSvc)
+		if owner == nil {
+			return ctrl.Result{}, fmt.Errorf("KeystoneService %s is not owned by a DesignateAPI", sharedKsSvc.Name)
+		}
+		serviceOwner = owner.Name
 	}
 
-	if (ctrlResult != ctrl.Result{}) {
-		return ctrlResult, nil
+	if serviceOwner != "" {
+		if sharedKsSvc.Status.ServiceID == "" {
+			instance.Status.Conditions.Set(condition.FalseCondition(
+				condition.KeystoneServiceReadyCondition,
+				condition.RequestedReason,
+				condition.SeverityInfo,
+				"Waiting for %s to register the keystone service", serviceOwner))
+			return ctrl.Result{RequeueAfter: time.Duration(10) * time.Second}, nil
+		}
+		instance.Status.ServiceID = sharedKsSvc.Status.ServiceID
+		instance.Status.Conditions.MarkTrue(
+			condition.KeystoneServiceReadyCondition,
+			"Keystone service registered by %s", serviceOwner)
+	} else {
+		//
+		// create service and user in keystone - https://docs.openstack.org/designate/latest/install/install-ubuntu.html#prerequisites
+		//
+		ksSvcSpec := keystonev1.KeystoneServiceSpec{
+			ServiceType:        designate.ServiceType,
+			ServiceName:        designate.ServiceName,
+			ServiceDescription: "Designate Service",
+			Enabled:            true,
+			ServiceUser:        instance.Spec.ServiceUser,
+			Secret:             instance.Spec.Secret,
+			PasswordSelector:   instance.Spec.PasswordSelectors.Service,
+		}
+		ksSvc := keystonev1.NewKeystoneService(ksSvcSpec, instance.Namespace, serviceLabels, time.Duration(10)*time.Second)
+		ctrlResult, err := ksSvc.CreateOrPatch(ctx, helper)
+		if err != nil {
+			instance.Status.Conditions.Set(condition.FalseCondition(
+				condition.KeystoneServiceReadyCondition,
+				condition.ErrorReason,
+				condition.SeverityWarning,
+				"Error registering the keystone service: %s",
+				err.Error()))
+			return ctrlResult, err
+		}
+		// mirror the Status, Reason, Severity and Message of the latest keystoneservice condition
+		// into a local condition with the type condition.KeystoneServiceReadyCondition
+		c := ksSvc.GetConditions().Mirror(condition.KeystoneServiceReadyCondition)
+		if c != nil {
+			instance.Status.Conditions.Set(c)
+		}
+
+		if (ctrlResult != ctrl.Result{}) {
+			return ctrlResult, nil
+		}
+		// wait for the keystone-operator to register the service
+		if c == nil || !instance.Status.Conditions.IsTrue(condition.KeystoneServiceReadyCondition) {
+			if c == nil {
+				instance.Status.Conditions.Set(condition.FalseCondition(
+					condition.KeystoneServiceReadyCondition,
+					condition.RequestedReason,
+					condition.SeverityInfo,
+					"Waiting for the keystone service to be registered"))
+			}
+			return ctrl.Result{RequeueAfter: time.Duration(10) * time.Second}, nil
+		}
+
+		instance.Status.ServiceID = ksSvc.GetServiceID()
 	}
 
-	instance.Status.ServiceID = ksSvc.GetServiceID()
+	// the region changed, remove the endpoints registered in the previous region
+	if len(instance.Status.RegionEndpointIDs) > 0 && instance.Status.Region != region {
+		ctrlResult, err := r.deleteRegionEndpoints(ctx, instance, helper, keystoneAPI)
+		if err != nil || (ctrlResult != ctrl.Result{}) {
+			return ctrlResult, err
+		}
+		instance.Status.Region = ""
+	}
+	// the endpoints moved off the region of the KeystoneAPI, remove the KeystoneEndpoint
+	if region != keystoneAPI.Spec.Region {
+		ksEndpt, err := keystonev1.GetKeystoneEndpointWithName(ctx, helper, instance.Name, instance.Namespace)
+		if err != nil && !k8s_errors.IsNotFound(err) {
+			return ctrl.Result{}, err
+		}
+		if err == nil && metav1.IsControlledBy(ksEndpt, instance) {
+			instance.Status.Region = ""
+			return r.deleteOrRetain(ctx, instance, helper, ksEndpt, "KeystoneEndpoint", false, condition.KeystoneEndpointReadyCondition)
+		}
+	}
 
 	//
-	// register endpoints
+	// register endpoints, the endpoints of a region get registered by a single DesignateAPI
 	//
-	ksEndptSpec := keystonev1.KeystoneEndpointSpec{
-		ServiceName: designate.ServiceName,
-		Endpoints:   instance.Status.APIEndpoints,
-	}
-	ksEndpt := keystonev1.NewKeystoneEndpoint(
-		designate.ServiceName,
-		instance.Namespace,
-		ksEndptSpec,
-		serviceLabels,
-		time.Duration(10)*time.Second)
-	ctrlResult, err = ksEndpt.CreateOrPatch(ctx, helper)
+	regionOwner, err := r.getRegionOwner(ctx, helper, instance, keystoneAPI.Spec.Region, region)
 	if err != nil {
-		return ctrlResult, err
+		return ctrl.Result{}, err
 	}
-	// mirror the Status, Reason, Severity and Message of the latest keystoneendpoint condition
-	// into a local condition with the type condition.KeystoneEndpointReadyCondition
-	c = ksEndpt.GetConditions().Mirror(condition.KeystoneEndpointReadyCondition)
-	if c != nil {
-		instance.Status.Conditions.Set(c)
+	if regionOwner != instance.Name {
+		instance.Status.Region = ""
+		instance.Status.Conditions.Set(condition.FalseCondition(
+			condition.KeystoneEndpointReadyCondition,
+			condition.ErrorReason,
+			condition.SeverityWarning,
+			"Keystone endpoints of region %s are registered by %s, set a different region", region, regionOwner))
+		return ctrl.Result{RequeueAfter: time.Duration(30) * time.Second}, nil
 	}
 
-	return ctrlResult, nil
-}
+	var ctrlResult ctrl.Result
+	if region != keystoneAPI.Spec.Region {
+		// the KeystoneEndpoint only registers endpoints in the region of the KeystoneAPI
+		ctrlResult, err = r.registerRegionEndpoints(ctx, instance, helper, keystoneAPI, region)
+	} else {
+		// previous versions of the operator named the KeystoneEndpoint after the service, remove it
+		// before registering the same endpoints with the KeystoneEndpoint of the instance
+		if instance.Name != designate.ServiceName {
+			legacyEndpt, err := keystonev1.GetKeystoneEndpointWithName(ctx, helper, designate.ServiceName, instance.Namespace)
+			if err != nil && !k8s_errors.IsNotFound(err) {
+				return ctrl.Result{}, err
+			}
+			if err == nil && metav1.IsControlledBy(legacyEndpt, instance) {
+				return r.deleteOrRetain(ctx, instance, helper, legacyEndpt, "KeystoneEndpoint", false, condition.KeystoneEndpointReadyCondition)
+			}
+		}
 
-func (r *DesignateAPIReconciler) reconcileUpdate(ctx context.Context, instance *designatev1.DesignateAPI, helper *helper.Helper) (ctrl.Result, error) {
-	r.Log.Info("Reconciling Service update")
+		ksEndptSpec := keystonev1.KeystoneEndpointSpec{
+			ServiceName: designate.ServiceName,
+			Endpoints:   instance.Status.APIEndpoints,
+		}
+		ksEndpt := keystonev1.NewKeystoneEndpoint(
+			instance.Name,
+			instance.Namespace,
+			ksEndptSpec,
+			serviceLabels,
+			time.Duration(10)*time.Second)
+		ctrlResult, err = ksEndpt.CreateOrPatch(ctx, helper)
+		if err != nil {
+			instance.Status.Conditions.Set(condition.FalseCondition(
+				condition.KeystoneEndpointReadyCondition,
+				condition.ErrorReason,
+				condition.SeverityWarning,
+				"Error registering the keystone endpoints: %s",
+				err.Error()))
+			return ctrlResult, err
+		}
+		// mirror the Status, Reason, Severity and Message of the latest keystoneendpoint condition
+		// into a local condition with the type condition.KeystoneEndpointReadyCondition
+		c := ksEndpt.GetConditions().Mirror(condition.KeystoneEndpointReadyCondition)
+		if c != nil {
+			instance.Status.Conditions.Set(c)
+		}
+		// wait for the keystone-operator to register the endpoints
+		if (ctrlResult == ctrl.Result{}) && (c == nil || !instance.Status.Conditions.IsTrue(condition.KeystoneEndpointReadyCondition)) {
+			if c == nil {
+				instance.Status.Conditions.Set(condition.FalseCondition(
+					condition.KeystoneEndpointReadyCondition,
+					condition.RequestedReason,
+					condition.SeverityInfo,
+					"Waiting for the keystone endpoints to be registered"))
+			}
+			ctrlResult = ctrl.Result{RequeueAfter: time.Duration(10) * time.Second}
+		}
+	}
+	if err != nil || (ctrlResult != ctrl.Result{}) {
+		return ctrlResult, err
+	}
 
-	// TODO: should have minor update tasks if required
-	// - delete dbsync hash from status to rerun it?
+	instance.Status.Region = region
 
-	r.Log.Info("Reconciled Service update successfully")
 	return ctrl.Result{}, nil
 }
 
-func (r *DesignateAPIReconciler) reconcileUpgrade(ctx context.Context, instance *designatev1.DesignateAPI, helper *helper.Helper) (ctrl.Result, error) {
-	r.Log.Info("Reconciling Service upgrade")
+// getRegionOwner - returns the name of the DesignateAPI which registers the endpoints of the region. The instance
+// which already registered the region keeps it, otherwise the oldest instance of the region gets it.
+func (r *DesignateAPIReconciler) getRegionOwner(
+	ctx context.Context,
+	h *helper.Helper,
+	instance *designatev1.DesignateAPI,
+	keystoneRegion string,
+	region string,
+) (string, error) {
+	designateAPIs := &designatev1.DesignateAPIList{}
+	err := h.GetClient().List(ctx, designateAPIs, client.InNamespace(instance.Namespace))
+	if err != nil {
+		return "", err
+	}
 
-	// TODO: should have major version upgrade tasks
-	// -delete dbsync hash from status to rerun it?
+	owner := instance
+	for i := range designateAPIs.Items {
+		candidate := &designateAPIs.Items[i]
+		if candidate.UID == instance.UID || !candidate.DeletionTimestamp.IsZero() ||
+			candidate.GetRegion(keystoneRegion) != region {
+			continue
+		}
+		if ownsRegionBefore(candidate, owner, region) {
+			owner = candidate
+		}
+	}
 
-	r.Log.Info("Reconciled Service upgrade successfully")
-	return ctrl.Result{}, nil
+	return owner.Name, nil
 }
 
-func (r *DesignateAPIReconciler) reconcileNormal(ctx context.Context, instance *designatev1.DesignateAPI, helper *helper.Helper) (ctrl.Result, error) {
-	r.Log.Info("Reconciling Service")
-
-	// ConfigMap
-	configMapVars := make(map[string]env.Setter)
+// ownsRegionBefore - whether a takes precedence over b as the owner of the region
+func ownsRegionBefore(a *designatev1.DesignateAPI, b *designatev1.DesignateAPI, region string) bool {
+	aRegistered := a.Status.Region == region
+	bRegistered := b.Status.Region == region
+	if aRegistered != bRegistered {
+		return aRegistered
+	}
+	if !a.CreationTimestamp.Equal(&b.CreationTimestamp) {
+		return a.CreationTimestamp.Before(&b.CreationTimestamp)
+	}
+	return a.Name < b.Name
+}
 
-	//
-	// check for required OpenStack secret holding passwords for service/admin user and add hash to the vars map
-	//
-	ospSecret, hash, err := oko_secret.GetSecret(ctx, helper, instance.Spec.Secret, instance.Namespace)
+// registerRegionEndpoints - registers the API endpoints in a region other than the region of the KeystoneAPI
+func (r *DesignateAPIReconciler) registerRegionEndpoints(
+	ctx context.Context,
+	instance *designatev1.DesignateAPI,
+	helper *helper.Helper,
+	keystoneAPI *keystonev1.KeystoneAPI,
+	region string,
+) (ctrl.Result, error) {
+	os, ctrlResult, err := keystonev1.GetAdminServiceClient(ctx, helper, keystoneAPI)
 	if err != nil {
-		if k8s_errors.IsNotFound(err) {
-			instance.Status.Conditions.Set(condition.FalseCondition(
-				condition.InputReadyCondition,
+		return ctrlResult, err
+	} else if (ctrlResult != ctrl.Result{}) {
+		return ctrlResult, nil
+	}
+
+	endpointIDs, err := designate.EnsureRegionEndpoints(
+		r.Log,
+		os,
+		region,
+		instance.Status.ServiceID,
+		instance.Status.APIEndpoints,
+		instance.Status.RegionEndpointIDs,
+	)
+	if err != nil {
+		instance.Status.Conditions.Set(condition.FalseCondition(
+			condition.KeystoneEndpointReadyCondition,
+			condition.ErrorReason,
+			condition.SeverityWarning,
+			"Error registering keystone endpoints in region %s: %s",
+			region,
+			err.Error()))
+		return ctrl.Result{}, err
+	}
+	instance.Status.RegionEndpointIDs = endpointIDs
+	instance.Status.Conditions.MarkTrue(
+		condition.KeystoneEndpointReadyCondition,
+		"Keystone endpoints registered in region %s", region)
+
+	return ctrl.Result{}, nil
+}
+
+// deleteRegionEndpoints - deletes the endpoints the instance registered in the region of the status
+func (r *DesignateAPIReconciler) deleteRegionEndpoints(
+	ctx context.Context,
+	instance *designatev1.DesignateAPI,
+	helper *helper.Helper,
+	keystoneAPI *keystonev1.KeystoneAPI,
+) (ctrl.Result, error) {
+	os, ctrlResult, err := keystonev1.GetAdminServiceClient(ctx, helper, keystoneAPI)
+	if err != nil {
+		return ctrlResult, err
+	} else if (ctrlResult != ctrl.Result{}) {
+		return ctrlResult, nil
+	}
+
+	err = designate.DeleteRegionEndpoints(r.Log, os, instance.Status.Region, instance.Status.RegionEndpointIDs)
+	if err != nil {
+		instance.Status.Conditions.Set(condition.FalseCondition(
+			condition.KeystoneEndpointReadyCondition,
+			condition.DeletionFailedReason,
+			condition.SeverityWarning,
+			"Error deleting keystone endpoints in region %s: %s", instance.Status.Region, err.Error()))
+		return ctrl.Result{}, err
+	}
+	util.LogForObject(helper, fmt.Sprintf("Removed keystone endpoints in region %s", instance.Status.Region), instance)
+	instance.Status.RegionEndpointIDs = nil
+
+	return ctrl.Result{}, nil
+}
+
+func (r *DesignateAPIReconciler) reconcileUpdate(ctx context.Context, instance *designatev1.DesignateAPI, helper *helper.Helper) (ctrl.Result, error) {
+	r.Log.Info("Reconciling Service update")
+
+	// TODO: should have minor update tasks if required
+	// - delete dbsync hash from status to rerun it?
+
+	r.Log.Info("Reconciled Service update successfully")
+	return ctrl.Result{}, nil
+}
+
+func (r *DesignateAPIReconciler) reconcileUpgrade(ctx context.Context, instance *designatev1.DesignateAPI, helper *helper.Helper) (ctrl.Result, error) {
+	r.Log.Info("Reconciling Service upgrade")
+
+	// TODO: should have major version upgrade tasks
+	// -delete dbsync hash from status to rerun it?
+
+	r.Log.Info("Reconciled Service upgrade successfully")
+	return ctrl.Result{}, nil
+}
+
+func (r *DesignateAPIReconciler) reconcileNormal(ctx context.Context, instance *designatev1.DesignateAPI, helper *helper.Helper) (ctrl.Result, error) {
+	r.Log.Info("Reconciling Service")
+
+	// ConfigMap
+	configMapVars := make(map[string]env.Setter)
+
+	//
+	// check for required OpenStack secret holding passwords for service/admin user and add hash to the vars map
+	//
+	ospSecret, hash, err := oko_secret.GetSecret(ctx, helper, instance.Spec.Secret, instance.Namespace)
+	if err != nil {
+		if k8s_errors.IsNotFound(err) {
+			instance.Status.Conditions.Set(condition.FalseCondition(
+				condition.InputReadyCondition,
 				condition.RequestedReason,
 				condition.SeverityInfo,
 				condition.InputReadyWaitingMessage))
@@ -520,6 +1109,40 @@ func (r *DesignateAPIReconciler) reconcileNormal(ctx context.Context, instance *
 	}
 	configMapVars[ospSecret.Name] = env.SetValue(hash)
 
+	//
+	// check for the required secret holding the transport_url of the message bus and add its hash to the vars map
+	//
+	transportURLSecret, hash, err := oko_secret.GetSecret(ctx, helper, instance.Spec.TransportURLSecret, instance.Namespace)
+	if err != nil {
+		if k8s_errors.IsNotFound(err) {
+			instance.Status.Conditions.Set(condition.FalseCondition(
+				condition.InputReadyCondition,
+				condition.RequestedReason,
+				condition.SeverityInfo,
+				condition.InputReadyWaitingMessage))
+			return ctrl.Result{RequeueAfter: time.Duration(10) * time.Second}, fmt.Errorf("transport URL secret %s not found", instance.Spec.TransportURLSecret)
+		}
+		instance.Status.Conditions.Set(condition.FalseCondition(
+			condition.InputReadyCondition,
+			condition.ErrorReason,
+			condition.SeverityWarning,
+			condition.InputReadyErrorMessage,
+			err.Error()))
+		return ctrl.Result{}, err
+	}
+	transportURL, ok := transportURLSecret.Data[designate.TransportURLSecretKey]
+	if !ok {
+		err = fmt.Errorf("%s not found in secret %s", designate.TransportURLSecretKey, transportURLSecret.Name)
+		instance.Status.Conditions.Set(condition.FalseCondition(
+			condition.InputReadyCondition,
+			condition.ErrorReason,
+			condition.SeverityWarning,
+			condition.InputReadyErrorMessage,
+			err.Error()))
+		return ctrl.Result{}, err
+	}
+	configMapVars[transportURLSecret.Name] = env.SetValue(hash)
+
 	instance.Status.Conditions.MarkTrue(condition.InputReadyCondition, condition.InputReadyMessage)
 
 	// run check OpenStack secret - end
@@ -531,10 +1154,10 @@ func (r *DesignateAPIReconciler) reconcileNormal(ctx context.Context, instance *
 	//
 	// create Configmap required for designate input
 	// - %-scripts configmap holding scripts to e.g. bootstrap the service
-	// - %-config configmap holding minimal designate config required to get the service up, user can add additional files to be added to the service
-	// - parameters which has passwords gets added from the OpenStack secret via the init container
+	// - %-config-data secret holding minimal designate config required to get the service up, user can add additional files to be added to the service
+	// - %-config-merged secret holding the rendered designate.conf including the passwords from the OpenStack secret
 	//
-	err = r.generateServiceConfigMaps(ctx, instance, helper, &configMapVars)
+	err = r.generateServiceConfigMaps(ctx, instance, helper, ospSecret, strings.TrimSuffix(string(transportURL), "\n"), &configMapVars)
 	if err != nil {
 		instance.Status.Conditions.Set(condition.FalseCondition(
 			condition.ServiceConfigReadyCondition,
@@ -545,6 +1168,9 @@ func (r *DesignateAPIReconciler) reconcileNormal(ctx context.Context, instance *
 		return ctrl.Result{}, err
 	}
 
+	// the metrics exporter sidecar is part of the pod, add its settings to the hash to roll out changes
+	configMapVars["metrics"] = env.SetValue(fmt.Sprintf("%t-%s", instance.Spec.Metrics.Enabled, instance.Spec.Metrics.ContainerImage))
+
 	//
 	// create hash over all the different input resources to identify if any those changed
 	// and a restart/recreate is required.
@@ -567,7 +1193,8 @@ func (r *DesignateAPIReconciler) reconcileNormal(ctx context.Context, instance *
 	//
 
 	serviceLabels := map[string]string{
-		common.AppSelector: designate.ServiceName,
+		common.AppSelector:       designate.ServiceName,
+		designate.OwnerNameLabel: instance.Name,
 	}
 
 	// Handle service init
@@ -578,6 +1205,12 @@ func (r *DesignateAPIReconciler) reconcileNormal(ctx context.Context, instance *
 		return ctrlResult, nil
 	}
 
+	// the neutron config snippet requires the endpoints exposed by reconcileInit
+	err = r.reconcileNeutronDNS(ctx, instance, helper, ospSecret)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
 	// Handle service update
 	ctrlResult, err = r.reconcileUpdate(ctx, instance, helper)
 	if err != nil {
@@ -598,6 +1231,25 @@ func (r *DesignateAPIReconciler) reconcileNormal(ctx context.Context, instance *
 	// normal reconcile tasks
 	//
 
+	// the selector of a Deployment is immutable, recreate it if the selector changed
+	deleted, err := r.deleteDeploymentWithStaleSelector(ctx, instance, helper, serviceLabels)
+	if err != nil {
+		instance.Status.Conditions.Set(condition.FalseCondition(
+			condition.DeploymentReadyCondition,
+			condition.ErrorReason,
+			condition.SeverityWarning,
+			condition.DeploymentReadyErrorMessage,
+			err.Error()))
+		return ctrl.Result{}, err
+	} else if deleted {
+		instance.Status.Conditions.Set(condition.FalseCondition(
+			condition.DeploymentReadyCondition,
+			condition.RequestedReason,
+			condition.SeverityInfo,
+			condition.DeploymentReadyRunningMessage))
+		return ctrl.Result{RequeueAfter: time.Duration(5) * time.Second}, nil
+	}
+
 	// Define a new Deployment object
 	depl := deployment.NewDeployment(
 		designate.Deployment(instance, inputHash, serviceLabels),
@@ -622,37 +1274,61 @@ func (r *DesignateAPIReconciler) reconcileNormal(ctx context.Context, instance *
 		return ctrlResult, nil
 	}
 	instance.Status.ReadyCount = depl.GetDeployment().Status.ReadyReplicas
-	if instance.Status.ReadyCount > 0 {
-		instance.Status.Conditions.MarkTrue(condition.DeploymentReadyCondition, condition.DeploymentReadyMessage)
+	setDeploymentConditions(instance, depl.GetDeployment())
+
+	ctrlResult, err = r.reconcileMetrics(ctx, instance, helper, serviceLabels)
+	if err != nil {
+		return ctrlResult, err
+	} else if (ctrlResult != ctrl.Result{}) {
+		return ctrlResult, nil
+	}
+
+	// the config data moved from a ConfigMap to a Secret, remove the orphaned
+	// ConfigMap once all pods got rolled out using the Secret
+	d := depl.GetDeployment()
+	if d.Status.ObservedGeneration == d.Generation && d.Status.UpdatedReplicas == instance.Spec.Replicas {
+		err = r.deleteOrphanedConfigMap(ctx, instance, helper)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		err = r.deleteLegacyObjects(ctx, instance, helper)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
 	}
 	// create Deployment - end
 
+	// all steps of the current spec got reconciled
+	instance.Status.ObservedGeneration = instance.Generation
+
 	r.Log.Info("Reconciled Service successfully")
 	return ctrl.Result{}, nil
 }
 
-// generateServiceConfigMaps - create create configmaps which hold scripts and service configuration
+// generateServiceConfigMaps - create configmaps which hold scripts and secrets which hold the service configuration
 // TODO add DefaultConfigOverwrite
 func (r *DesignateAPIReconciler) generateServiceConfigMaps(
 	ctx context.Context,
 	instance *designatev1.DesignateAPI,
 	h *helper.Helper,
+	ospSecret *corev1.Secret,
+	transportURL string,
 	envVars *map[string]env.Setter,
 ) error {
 	//
 	// create Configmap/Secret required for designate input
 	// - %-scripts configmap holding scripts to e.g. bootstrap the service
-	// - %-config configmap holding minimal designate config required to get the service up, user can add additional files to be added to the service
-	// - parameters which has passwords gets added from the ospSecret via the init container
+	// - %-config-data secret holding minimal designate config required to get the service up, user can add additional files to be added to the service.
+	//   A secret is used as the DefaultConfigOverwrite files might hold credentials.
+	// - %-config-merged secret holding the designate.conf with the CustomServiceConfig merged, the passwords from the ospSecret
+	//   and the transport_url set
 	//
 
 	cmLabels := labels.GetLabels(instance, labels.GetGroupLabel(designate.ServiceName), map[string]string{})
 
 	// customData hold any customization for the service.
-	// custom.conf is going to /etc/<service>/<service>.conf.d
-	// all other files get placed into /etc/<service> to allow overwrite of e.g. logging.conf or policy.json
-	// TODO: make sure custom.conf can not be overwritten
-	customData := map[string]string{common.CustomServiceConfigFileName: instance.Spec.CustomServiceConfig}
+	// all files get placed into /etc/<service> to allow overwrite of e.g. logging.conf or policy.json
+	customData := map[string]string{}
 	for key, data := range instance.Spec.DefaultConfigOverwrite {
 		customData[key] = data
 	}
@@ -669,22 +1345,53 @@ func (r *DesignateAPIReconciler) generateServiceConfigMaps(
 	if err != nil {
 		return err
 	}
-	templateParameters := make(map[string]interface{})
-	templateParameters["ServiceUser"] = instance.Spec.ServiceUser
-	templateParameters["KeystoneInternalURL"] = keystoneInternalURL
-	templateParameters["KeystonePublicURL"] = keystonePublicURL
+	databasePassword, ok := ospSecret.Data[instance.Spec.PasswordSelectors.Database]
+	if !ok {
+		return fmt.Errorf("%s not found in secret %s", instance.Spec.PasswordSelectors.Database, ospSecret.Name)
+	}
+	servicePassword, ok := ospSecret.Data[instance.Spec.PasswordSelectors.Service]
+	if !ok {
+		return fmt.Errorf("%s not found in secret %s", instance.Spec.PasswordSelectors.Service, ospSecret.Name)
+	}
+
+	templateParameters := designate.TemplateParameters(
+		instance,
+		keystoneInternalURL,
+		keystonePublicURL,
+		instance.GetRegion(keystoneAPI.Spec.Region),
+	)
 
 	cms := []util.Template{
 		// ScriptsConfigMap
 		{
-			Name:               fmt.Sprintf("%s-scripts", instance.Name),
-			Namespace:          instance.Namespace,
-			Type:               util.TemplateTypeScripts,
-			InstanceType:       instance.Kind,
-			AdditionalTemplate: map[string]string{"common.sh": "/common/common.sh"},
-			Labels:             cmLabels,
+			Name:         fmt.Sprintf("%s-scripts", instance.Name),
+			Namespace:    instance.Namespace,
+			Type:         util.TemplateTypeScripts,
+			InstanceType: instance.Kind,
+			Labels:       cmLabels,
 		},
-		// ConfigMap
+	}
+	err = configmap.EnsureConfigMaps(ctx, h, instance, cms, envVars)
+	if err != nil {
+		return err
+	}
+
+	// parameters holding passwords only get rendered into the secret
+	secretParameters := designate.SecretTemplateParameters(
+		instance,
+		templateParameters,
+		strings.TrimSuffix(string(databasePassword), "\n"),
+		strings.TrimSuffix(string(servicePassword), "\n"),
+		transportURL,
+	)
+
+	serviceConfig, err := designate.RenderServiceConfig(designate.ServiceConfigTemplate, secretParameters, instance.Spec.CustomServiceConfig)
+	if err != nil {
+		return err
+	}
+
+	secrets := []util.Template{
+		// ConfigSecret
 		{
 			Name:          fmt.Sprintf("%s-config-data", instance.Name),
 			Namespace:     instance.Namespace,
@@ -694,8 +1401,362 @@ func (r *DesignateAPIReconciler) generateServiceConfigMaps(
 			ConfigOptions: templateParameters,
 			Labels:        cmLabels,
 		},
+		// MergedConfigSecret
+		{
+			Name:         fmt.Sprintf("%s-config-merged", instance.Name),
+			Namespace:    instance.Namespace,
+			Type:         util.TemplateTypeNone,
+			InstanceType: instance.Kind,
+			CustomData:   map[string]string{designate.ServiceConfigFileName: serviceConfig},
+			Labels:       cmLabels,
+		},
+	}
+	return oko_secret.EnsureSecrets(ctx, h, instance, secrets, envVars)
+}
+
+// reconcileNeutronDNS - creates or updates the %-neutron-dns secret holding the config neutron needs
+// to use designate as its external DNS driver, or removes it if the integration got disabled. The
+// secret is not an input of the designate pods, so changes of it do not restart them.
+func (r *DesignateAPIReconciler) reconcileNeutronDNS(
+	ctx context.Context,
+	instance *designatev1.DesignateAPI,
+	h *helper.Helper,
+	ospSecret *corev1.Secret,
+) error {
+	if !instance.Spec.NeutronDNS.Enabled {
+		if instance.Status.NeutronDNSSecret == "" {
+			return nil
+		}
+		err := oko_secret.DeleteSecretsWithName(ctx, h, instance.Status.NeutronDNSSecret, instance.Namespace)
+		if err != nil {
+			return err
+		}
+		instance.Status.NeutronDNSSecret = ""
+		return nil
+	}
+
+	keystoneAPI, err := keystonev1.GetKeystoneAPI(ctx, h, instance.Namespace, map[string]string{})
+	if err != nil {
+		return err
+	}
+	keystoneInternalURL, err := keystoneAPI.GetEndpoint(endpoint.EndpointInternal)
+	if err != nil {
+		return err
+	}
+	servicePassword, ok := ospSecret.Data[instance.Spec.PasswordSelectors.Service]
+	if !ok {
+		return fmt.Errorf("%s not found in secret %s", instance.Spec.PasswordSelectors.Service, ospSecret.Name)
+	}
+
+	neutronConfig, err := designate.RenderNeutronDNSConfig(
+		instance,
+		keystoneInternalURL,
+		instance.GetRegion(keystoneAPI.Spec.Region),
+		strings.TrimSuffix(string(servicePassword), "\n"),
+	)
+	if err != nil {
+		return err
+	}
+
+	secrets := []util.Template{
+		{
+			Name:         designate.NeutronDNSSecretName(instance),
+			Namespace:    instance.Namespace,
+			Type:         util.TemplateTypeNone,
+			InstanceType: instance.Kind,
+			CustomData:   map[string]string{designate.NeutronDNSConfigFileName: neutronConfig},
+			Labels:       labels.GetLabels(instance, labels.GetGroupLabel(designate.ServiceName), map[string]string{}),
+		},
 	}
-	return configmap.EnsureConfigMaps(ctx, h, instance, cms, envVars)
+	// the hash is not added to the deployment inputs
+	envVars := map[string]env.Setter{}
+	err = oko_secret.EnsureSecrets(ctx, h, instance, secrets, &envVars)
+	if err != nil {
+		return err
+	}
+	instance.Status.NeutronDNSSecret = designate.NeutronDNSSecretName(instance)
+
+	return nil
+}
+
+// setDeploymentConditions - sets the Available, Progressing, Degraded and DeploymentReady conditions
+// from the rollout status of the deployment
+func setDeploymentConditions(instance *designatev1.DesignateAPI, d appsv1.Deployment) {
+	desired := instance.Spec.Replicas
+	ready := d.Status.ReadyReplicas
+
+	minReadyPercent := instance.Spec.MinReadyPercent
+	if minReadyPercent <= 0 {
+		minReadyPercent = 100
+	}
+	// round up, so that e.g. 50% of 3 replicas requires 2 ready replicas
+	required := (desired*minReadyPercent + 99) / 100
+
+	// Available
+	available := ready >= required
+	if available {
+		instance.Status.Conditions.MarkTrue(
+			designatev1.DesignateAPIAvailableCondition,
+			designatev1.DesignateAPIAvailableMessage,
+			ready, desired)
+	} else {
+		instance.Status.Conditions.Set(condition.FalseCondition(
+			designatev1.DesignateAPIAvailableCondition,
+			designatev1.InsufficientReplicasReason,
+			condition.SeverityWarning,
+			designatev1.DesignateAPIAvailableErrorMessage,
+			ready, desired, required))
+	}
+
+	// Progressing, the rollout is complete when the deployment controller observed the current
+	// spec and all desired replicas are updated and available without any old replicas left
+	deadlineExceeded := false
+	for _, c := range d.Status.Conditions {
+		if c.Type == appsv1.DeploymentProgressing && c.Status == corev1.ConditionFalse &&
+			c.Reason == string(designatev1.ProgressDeadlineExceededReason) {
+			deadlineExceeded = true
+		}
+	}
+	rolloutComplete := d.Status.ObservedGeneration >= d.Generation &&
+		d.Status.UpdatedReplicas == desired &&
+		d.Status.Replicas == desired &&
+		d.Status.AvailableReplicas == desired
+
+	switch {
+	case deadlineExceeded:
+		instance.Status.Conditions.Set(condition.FalseCondition(
+			designatev1.DesignateAPIProgressingCondition,
+			designatev1.ProgressDeadlineExceededReason,
+			condition.SeverityError,
+			"Deployment %s exceeded its progress deadline", d.Name))
+	case rolloutComplete:
+		instance.Status.Conditions.MarkTrue(
+			designatev1.DesignateAPIProgressingCondition,
+			designatev1.DesignateAPIProgressingCompleteMessage)
+	default:
+		instance.Status.Conditions.MarkTrue(
+			designatev1.DesignateAPIProgressingCondition,
+			designatev1.DesignateAPIProgressingMessage,
+			d.Status.UpdatedReplicas, desired)
+	}
+
+	// Degraded
+	switch {
+	case deadlineExceeded:
+		instance.Status.Conditions.Set(&condition.Condition{
+			Type:     designatev1.DesignateAPIDegradedCondition,
+			Status:   corev1.ConditionTrue,
+			Reason:   designatev1.ProgressDeadlineExceededReason,
+			Severity: condition.SeverityError,
+			Message:  fmt.Sprintf("Deployment %s exceeded its progress deadline", d.Name),
+		})
+	case !available && rolloutComplete || !available && instance.Status.Conditions.IsTrue(condition.DeploymentReadyCondition):
+		// the replicas became unavailable after the rollout
+		instance.Status.Conditions.Set(&condition.Condition{
+			Type:     designatev1.DesignateAPIDegradedCondition,
+			Status:   corev1.ConditionTrue,
+			Reason:   designatev1.InsufficientReplicasReason,
+			Severity: condition.SeverityWarning,
+			Message:  fmt.Sprintf(designatev1.DesignateAPIAvailableErrorMessage, ready, desired, required),
+		})
+	default:
+		instance.Status.Conditions.Set(&condition.Condition{
+			Type:     designatev1.DesignateAPIDegradedCondition,
+			Status:   corev1.ConditionFalse,
+			Reason:   condition.ReadyReason,
+			Severity: condition.SeverityNone,
+			Message:  designatev1.DesignateAPIDegradedMessage,
+		})
+	}
+
+	// DeploymentReady
+	if available && !deadlineExceeded {
+		instance.Status.Conditions.MarkTrue(condition.DeploymentReadyCondition, condition.DeploymentReadyMessage)
+	} else {
+		instance.Status.Conditions.Set(condition.FalseCondition(
+			condition.DeploymentReadyCondition,
+			condition.RequestedReason,
+			condition.SeverityInfo,
+			condition.DeploymentReadyRunningMessage))
+	}
+}
+
+// reconcileMetrics - creates the Service and ServiceMonitor for the metrics exporter sidecar if metrics
+// are enabled, or removes them. If the prometheus-operator is not installed no ServiceMonitor gets created.
+func (r *DesignateAPIReconciler) reconcileMetrics(
+	ctx context.Context,
+	instance *designatev1.DesignateAPI,
+	h *helper.Helper,
+	serviceLabels map[string]string,
+) (ctrl.Result, error) {
+	serviceMonitor := designate.ServiceMonitor(instance, serviceLabels)
+	metricsService := service.NewService(
+		designate.MetricsService(instance, serviceLabels),
+		serviceLabels,
+		time.Duration(5)*time.Second,
+	)
+
+	if !instance.Spec.Metrics.Enabled {
+		// the metrics Service gets created before and deleted after the ServiceMonitor, only
+		// clean up if it exists. The Service is read from the cache.
+		err := h.GetClient().Get(ctx, types.NamespacedName{Name: designate.MetricsName(instance), Namespace: instance.Namespace}, &corev1.Service{})
+		if err != nil {
+			if k8s_errors.IsNotFound(err) {
+				return ctrl.Result{}, nil
+			}
+			return ctrl.Result{}, err
+		}
+
+		err = h.GetClient().Delete(ctx, serviceMonitor)
+		if err != nil && !k8s_errors.IsNotFound(err) && !meta.IsNoMatchError(err) {
+			return ctrl.Result{}, err
+		}
+		return ctrl.Result{}, metricsService.Delete(ctx, h)
+	}
+
+	ctrlResult, err := metricsService.CreateOrPatch(ctx, h)
+	if err != nil {
+		return ctrlResult, err
+	} else if (ctrlResult != ctrl.Result{}) {
+		return ctrlResult, nil
+	}
+
+	desired := serviceMonitor.DeepCopy()
+	op, err := controllerutil.CreateOrPatch(ctx, h.GetClient(), serviceMonitor, func() error {
+		serviceMonitor.SetLabels(util.MergeStringMaps(serviceMonitor.GetLabels(), desired.GetLabels()))
+		serviceMonitor.Object["spec"] = desired.Object["spec"]
+
+		return controllerutil.SetControllerReference(instance, serviceMonitor, r.Scheme)
+	})
+	if err != nil {
+		if meta.IsNoMatchError(err) {
+			util.LogForObject(h, "ServiceMonitor CRD not installed, skipping ServiceMonitor creation", instance)
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+	if op != controllerutil.OperationResultNone {
+		util.LogForObject(h, fmt.Sprintf("ServiceMonitor %s - %s", serviceMonitor.GetName(), op), instance)
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// deleteOrphanedConfigMap - deletes the %-config-data ConfigMap created by previous versions of the
+// operator, the config data is now stored in a Secret. The ConfigMap is read from the cache and only
+// deleted if the instance owns it.
+func (r *DesignateAPIReconciler) deleteOrphanedConfigMap(
+	ctx context.Context,
+	instance *designatev1.DesignateAPI,
+	h *helper.Helper,
+) error {
+	cm := &corev1.ConfigMap{}
+	err := h.GetClient().Get(ctx, types.NamespacedName{Name: fmt.Sprintf("%s-config-data", instance.Name), Namespace: instance.Namespace}, cm)
+	if err != nil {
+		if k8s_errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	if !metav1.IsControlledBy(cm, instance) || !cm.DeletionTimestamp.IsZero() {
+		return nil
+	}
+
+	err = h.GetClient().Delete(ctx, cm)
+	if err != nil {
+		if k8s_errors.IsNotFound(err) {
+			return nil
+		}
+		return err
+	}
+	util.LogForObject(h, fmt.Sprintf("Deleted orphaned ConfigMap %s", cm.Name), instance)
+
+	return nil
+}
+
+// deleteDeploymentWithStaleSelector - deletes the Deployment of the instance if its selector does not match
+// the labels anymore, the selector of a Deployment is immutable. Returns true if the Deployment got deleted or
+// is still being deleted.
+func (r *DesignateAPIReconciler) deleteDeploymentWithStaleSelector(
+	ctx context.Context,
+	instance *designatev1.DesignateAPI,
+	h *helper.Helper,
+	labels map[string]string,
+) (bool, error) {
+	d := &appsv1.Deployment{}
+	err := h.GetClient().Get(ctx, types.NamespacedName{Name: instance.Name, Namespace: instance.Namespace}, d)
+	if err != nil {
+		if k8s_errors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	if !d.DeletionTimestamp.IsZero() {
+		return true, nil
+	}
+	if !metav1.IsControlledBy(d, instance) ||
+		(d.Spec.Selector != nil && equality.Semantic.DeepEqual(d.Spec.Selector.MatchLabels, labels)) {
+		return false, nil
+	}
+
+	err = h.GetClient().Delete(ctx, d, client.PropagationPolicy(metav1.DeletePropagationBackground))
+	if err != nil && !k8s_errors.IsNotFound(err) {
+		return false, err
+	}
+	util.LogForObject(h, fmt.Sprintf("Deleted Deployment %s to recreate it with the new selector", d.Name), instance)
+
+	return true, nil
+}
+
+// deleteLegacyObjects - deletes the Deployment, the db-sync Job, the Services and the Routes which previous
+// versions of the operator named after the service instead of the DesignateAPI, if the instance still owns them
+func (r *DesignateAPIReconciler) deleteLegacyObjects(
+	ctx context.Context,
+	instance *designatev1.DesignateAPI,
+	h *helper.Helper,
+) error {
+	if instance.Name == designate.ServiceName {
+		return nil
+	}
+
+	legacyMeta := func(name string) metav1.ObjectMeta {
+		return metav1.ObjectMeta{Name: name, Namespace: instance.Namespace}
+	}
+	objs := []client.Object{
+		&appsv1.Deployment{ObjectMeta: legacyMeta(designate.ServiceName)},
+		&batchv1.Job{ObjectMeta: legacyMeta(designate.ServiceName + "-db-sync")},
+	}
+	for _, endpointType := range []endpoint.Endpoint{endpoint.EndpointAdmin, endpoint.EndpointInternal, endpoint.EndpointPublic} {
+		name := designate.ServiceName + "-" + string(endpointType)
+		objs = append(objs,
+			&corev1.Service{ObjectMeta: legacyMeta(name)},
+			&routev1.Route{ObjectMeta: legacyMeta(name)})
+	}
+
+	for _, obj := range objs {
+		err := h.GetClient().Get(ctx, client.ObjectKeyFromObject(obj), obj)
+		if err != nil {
+			if k8s_errors.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+		if !metav1.IsControlledBy(obj, instance) || !obj.GetDeletionTimestamp().IsZero() {
+			continue
+		}
+
+		err = h.GetClient().Delete(ctx, obj, client.PropagationPolicy(metav1.DeletePropagationBackground))
+		if err != nil && !k8s_errors.IsNotFound(err) {
+			return err
+		}
+		gvk, err := apiutil.GVKForObject(obj, h.GetScheme())
+		if err != nil {
+			return err
+		}
+		util.LogForObject(h, fmt.Sprintf("Deleted legacy %s %s", gvk.Kind, obj.GetName()), instance)
+	}
+
+	return nil
 }
 
 // createHashOfInputHashes - creates a hash of hashes which gets added to the resources which requires a restart
@@ -720,3 +1781,43 @@ func (r *DesignateAPIReconciler) createHashOfInputHashes(
 	}
 	return hash, changed, nil
 }
+
+// recordConditionEvents - emits an event for every condition of the instance which changed its
+// state compared to savedConditions. Only status, reason and severity are compared, so requeue
+// loops which just update the message of a condition do not emit repeated events. Conditions
+// which are False with a Warning or Error severity, and Degraded=True, are reported as Warning events.
+func (r *DesignateAPIReconciler) recordConditionEvents(
+	instance *designatev1.DesignateAPI,
+	savedConditions condition.Conditions,
+) {
+	if r.Recorder == nil {
+		return
+	}
+
+	for _, c := range instance.Status.Conditions {
+		saved := savedConditions.Get(c.Type)
+		if saved != nil &&
+			saved.Status == c.Status &&
+			saved.Reason == c.Reason &&
+			saved.Severity == c.Severity {
+			continue
+		}
+
+		eventType := corev1.EventTypeNormal
+		if c.Status == corev1.ConditionFalse &&
+			(c.Severity == condition.SeverityWarning || c.Severity == condition.SeverityError) {
+			eventType = corev1.EventTypeWarning
+		}
+		// Degraded is the only condition which reports a problem with Status=True
+		if c.Type == designatev1.DesignateAPIDegradedCondition && c.Status == corev1.ConditionTrue {
+			eventType = corev1.EventTypeWarning
+		}
+
+		message := fmt.Sprintf("%s is %s", c.Type, c.Status)
+		if c.Message != "" {
+			message = fmt.Sprintf("%s: %s", message, c.Message)
+		}
+
+		r.Recorder.Event(instance, eventType, string(c.Reason), message)
+	}
+}
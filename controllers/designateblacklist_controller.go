@@ -0,0 +1,103 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	designatev1 "github.com/openstack-k8s-operators/designate-operator/api/v1beta1"
+	"github.com/openstack-k8s-operators/designate-operator/pkg/designateblacklist"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DesignateBlacklistReconciler reconciles a DesignateBlacklist object
+type DesignateBlacklistReconciler struct {
+	client.Client
+	Kclient kubernetes.Interface
+	Log     logr.Logger
+	Scheme  *runtime.Scheme
+	// NewDesignateClient - creates the designate API clients, defaults to designateclient.NewClient
+	NewDesignateClient DesignateClientFunc
+}
+
+// +kubebuilder:rbac:groups=designate.openstack.org,resources=designateblacklists,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=designate.openstack.org,resources=designateblacklists/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=designate.openstack.org,resources=designateblacklists/finalizers,verbs=update
+// +kubebuilder:rbac:groups=designate.openstack.org,resources=designateapis,verbs=get;list;watch;
+// +kubebuilder:rbac:groups=keystone.openstack.org,resources=keystoneapis,verbs=get;list;watch;
+// +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;
+
+// blacklistEntryKind - the blacklist entry of a DesignateBlacklist is an entry of the designate blacklists
+var blacklistEntryKind = designateEntryKind[*designatev1.DesignateBlacklist]{
+	kind:              "DesignateBlacklist",
+	name:              "blacklist entry",
+	readyCondition:    designatev1.DesignateBlacklistReadyCondition,
+	readyInitMessage:  designatev1.DesignateBlacklistReadyInitMessage,
+	readyMessage:      designatev1.DesignateBlacklistReadyMessage,
+	readyErrorMessage: designatev1.DesignateBlacklistReadyErrorMessage,
+	conflictMessage:   designatev1.DesignateBlacklistConflictMessage,
+	newInstance: func() *designatev1.DesignateBlacklist {
+		return &designatev1.DesignateBlacklist{}
+	},
+	newList: func() client.ObjectList {
+		return &designatev1.DesignateBlacklistList{}
+	},
+	designateAPI: func(instance *designatev1.DesignateBlacklist) string {
+		return instance.Spec.DesignateAPI
+	},
+	key: func(instance *designatev1.DesignateBlacklist) string {
+		return instance.Spec.Pattern
+	},
+	equalKeys: designateblacklist.EqualPatterns,
+	status: func(instance *designatev1.DesignateBlacklist) designateEntryStatus {
+		return designateEntryStatus{
+			Conditions:         &instance.Status.Conditions,
+			ObservedGeneration: &instance.Status.ObservedGeneration,
+			ID:                 &instance.Status.BlacklistID,
+			Adopted:            &instance.Status.Adopted,
+		}
+	},
+	ensure: designateblacklist.EnsureBlacklist,
+	delete: designateblacklist.DeleteBlacklist,
+}
+
+// Reconcile - creates the blacklist entry of the DesignateBlacklist with the designate API and
+// corrects it if it drifted
+func (r *DesignateBlacklistReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return r.entryReconciler().Reconcile(ctx, req)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *DesignateBlacklistReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return r.entryReconciler().SetupWithManager(mgr)
+}
+
+// entryReconciler - returns the reconciler of the blacklist entries with the clients of the reconciler
+func (r *DesignateBlacklistReconciler) entryReconciler() *designateEntryReconciler[*designatev1.DesignateBlacklist] {
+	return &designateEntryReconciler[*designatev1.DesignateBlacklist]{
+		Client:             r.Client,
+		Kclient:            r.Kclient,
+		Log:                r.Log,
+		Scheme:             r.Scheme,
+		NewDesignateClient: r.NewDesignateClient,
+		kind:               blacklistEntryKind,
+	}
+}
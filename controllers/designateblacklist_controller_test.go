@@ -0,0 +1,127 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	designatev1 "github.com/openstack-k8s-operators/designate-operator/api/v1beta1"
+	"github.com/openstack-k8s-operators/designate-operator/pkg/designateclient/fake"
+	"github.com/openstack-k8s-operators/lib-common/modules/common/condition"
+
+	corev1 "k8s.io/api/core/v1"
+	k8s_errors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ = Describe("DesignateBlacklist controller", func() {
+	var namespace string
+	var name types.NamespacedName
+	var spec designatev1.DesignateBlacklistSpec
+
+	BeforeEach(func() {
+		namespace = CreateNamespace()
+		name = types.NamespacedName{Namespace: namespace, Name: "internal-corp"}
+		spec = designatev1.DesignateBlacklistSpec{
+			DesignateAPI: "designate",
+			// the fake server is shared by all tests and the patterns are unique
+			Pattern:     `^([A-Za-z0-9_\-]+\.)*` + namespace + `\.corp\.$`,
+			Description: "zones of the internal DNS",
+		}
+	})
+
+	When("the DesignateAPI does not exist", func() {
+		BeforeEach(func() {
+			CreateObject(name, &designatev1.DesignateBlacklist{Spec: spec})
+		})
+
+		It("waits for the DesignateAPI", func() {
+			Eventually(func(g Gomega) {
+				c := GetObject[designatev1.DesignateBlacklist](name).Status.Conditions.Get(condition.InputReadyCondition)
+				g.Expect(c).NotTo(BeNil())
+				g.Expect(c.Status).To(Equal(corev1.ConditionFalse))
+				g.Expect(c.Reason).To(Equal(condition.RequestedReason))
+			}, timeout, interval).Should(Succeed())
+		})
+	})
+
+	When("the DesignateAPI is ready", func() {
+		BeforeEach(func() {
+			CreateReadyDesignateAPI(types.NamespacedName{Namespace: namespace, Name: "designate"})
+		})
+
+		It("creates and deletes the entry", func() {
+			CreateObject(name, &designatev1.DesignateBlacklist{Spec: spec})
+
+			var blacklistID string
+			Eventually(func(g Gomega) {
+				instance := GetObject[designatev1.DesignateBlacklist](name)
+				g.Expect(instance.Status.Conditions.IsTrue(condition.ReadyCondition)).To(BeTrue())
+				g.Expect(instance.Status.Adopted).To(BeFalse())
+				blacklistID = instance.Status.BlacklistID
+			}, timeout, interval).Should(Succeed())
+			Expect(*designateServer.GetBlacklist(blacklistID)).To(Equal(fake.Blacklist{
+				ID:          blacklistID,
+				Pattern:     spec.Pattern,
+				Description: spec.Description,
+			}))
+
+			Expect(k8sClient.Delete(ctx, GetObject[designatev1.DesignateBlacklist](name))).To(Succeed())
+			Eventually(func() bool {
+				return k8s_errors.IsNotFound(k8sClient.Get(ctx, name, &designatev1.DesignateBlacklist{}))
+			}, timeout, interval).Should(BeTrue())
+			Expect(designateServer.GetBlacklist(blacklistID)).To(BeNil())
+		})
+
+		It("does not manage the entry of another DesignateBlacklist", func() {
+			CreateObject(name, &designatev1.DesignateBlacklist{Spec: spec})
+			Eventually(func(g Gomega) {
+				g.Expect(GetObject[designatev1.DesignateBlacklist](name).Status.BlacklistID).NotTo(BeEmpty())
+			}, timeout, interval).Should(Succeed())
+
+			otherName := types.NamespacedName{Namespace: namespace, Name: "internal-corp-other"}
+			CreateObject(otherName, &designatev1.DesignateBlacklist{Spec: spec})
+			Eventually(func(g Gomega) {
+				instance := GetObject[designatev1.DesignateBlacklist](otherName)
+				c := instance.Status.Conditions.Get(designatev1.DesignateBlacklistReadyCondition)
+				g.Expect(c).NotTo(BeNil())
+				g.Expect(c.Status).To(Equal(corev1.ConditionFalse))
+				g.Expect(c.Reason).To(Equal(condition.ErrorReason))
+				g.Expect(instance.Status.BlacklistID).To(BeEmpty())
+			}, timeout, interval).Should(Succeed())
+		})
+
+		It("adopts an existing entry and keeps it on delete", func() {
+			blacklistID := designateServer.AddBlacklist(fake.Blacklist{Pattern: spec.Pattern})
+			CreateObject(name, &designatev1.DesignateBlacklist{Spec: spec})
+
+			Eventually(func(g Gomega) {
+				instance := GetObject[designatev1.DesignateBlacklist](name)
+				g.Expect(instance.Status.Conditions.IsTrue(condition.ReadyCondition)).To(BeTrue())
+				g.Expect(instance.Status.BlacklistID).To(Equal(blacklistID))
+				g.Expect(instance.Status.Adopted).To(BeTrue())
+			}, timeout, interval).Should(Succeed())
+			Expect(designateServer.GetBlacklist(blacklistID).Description).To(Equal(spec.Description))
+
+			Expect(k8sClient.Delete(ctx, GetObject[designatev1.DesignateBlacklist](name))).To(Succeed())
+			Eventually(func() bool {
+				return k8s_errors.IsNotFound(k8sClient.Get(ctx, name, &designatev1.DesignateBlacklist{}))
+			}, timeout, interval).Should(BeTrue())
+			Expect(designateServer.GetBlacklist(blacklistID)).NotTo(BeNil())
+		})
+	})
+})
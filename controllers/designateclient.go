@@ -0,0 +1,105 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gophercloud/gophercloud"
+	designatev1 "github.com/openstack-k8s-operators/designate-operator/api/v1beta1"
+	"github.com/openstack-k8s-operators/designate-operator/pkg/designateclient"
+	keystonev1 "github.com/openstack-k8s-operators/keystone-operator/api/v1beta1"
+	"github.com/openstack-k8s-operators/lib-common/modules/common/endpoint"
+	"github.com/openstack-k8s-operators/lib-common/modules/common/helper"
+	oko_secret "github.com/openstack-k8s-operators/lib-common/modules/common/secret"
+	"github.com/openstack-k8s-operators/lib-common/modules/openstack"
+
+	k8s_errors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+)
+
+// designateAPIField - index of the designate resources by the name of the referenced DesignateAPI
+const designateAPIField = ".spec.designateAPI"
+
+// DesignateClientFunc - creates a client of the designate v2 API, see designateclient.NewClient
+type DesignateClientFunc func(authOpts openstack.AuthOpts, designateURL string) (*gophercloud.ServiceClient, error)
+
+// getDesignateClient - returns a client of the designate API of the DesignateAPI with the name in the
+// namespace, authenticated as the designate service user at the internal keystone endpoint. Requeues
+// while the DesignateAPI does not exist or is not ready.
+func getDesignateClient(
+	ctx context.Context,
+	h *helper.Helper,
+	newClient DesignateClientFunc,
+	namespace string,
+	name string,
+) (*gophercloud.ServiceClient, ctrl.Result, error) {
+	designateAPI := &designatev1.DesignateAPI{}
+	err := h.GetClient().Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, designateAPI)
+	if err != nil {
+		if k8s_errors.IsNotFound(err) {
+			return nil, ctrl.Result{RequeueAfter: time.Second * 10}, nil
+		}
+		return nil, ctrl.Result{}, err
+	}
+	if !designateAPI.IsReady() {
+		return nil, ctrl.Result{RequeueAfter: time.Second * 10}, nil
+	}
+
+	designateURL, err := designateAPI.GetEndpoint(endpoint.EndpointInternal)
+	if err != nil {
+		return nil, ctrl.Result{}, err
+	}
+
+	keystoneAPI, err := keystonev1.GetKeystoneAPI(ctx, h, namespace, map[string]string{})
+	if err != nil {
+		return nil, ctrl.Result{}, err
+	}
+	keystoneInternalURL, err := keystoneAPI.GetEndpoint(endpoint.EndpointInternal)
+	if err != nil {
+		return nil, ctrl.Result{}, err
+	}
+
+	ospSecret, _, err := oko_secret.GetSecret(ctx, h, designateAPI.Spec.Secret, namespace)
+	if err != nil {
+		return nil, ctrl.Result{}, err
+	}
+	servicePassword, ok := ospSecret.Data[designateAPI.Spec.PasswordSelectors.Service]
+	if !ok {
+		return nil, ctrl.Result{}, fmt.Errorf("%s not found in secret %s", designateAPI.Spec.PasswordSelectors.Service, ospSecret.Name)
+	}
+
+	if newClient == nil {
+		newClient = designateclient.NewClient
+	}
+	client, err := newClient(openstack.AuthOpts{
+		AuthURL:    keystoneInternalURL,
+		Username:   designateAPI.Spec.ServiceUser,
+		Password:   strings.TrimSuffix(string(servicePassword), "\n"),
+		TenantName: "service",
+		DomainName: "Default",
+		Region:     designateAPI.GetRegion(keystoneAPI.Spec.Region),
+	}, designateURL)
+	if err != nil {
+		return nil, ctrl.Result{}, err
+	}
+
+	return client, ctrl.Result{}, nil
+}
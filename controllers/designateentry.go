@@ -0,0 +1,372 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"github.com/gophercloud/gophercloud"
+	designatev1 "github.com/openstack-k8s-operators/designate-operator/api/v1beta1"
+	"github.com/openstack-k8s-operators/designate-operator/pkg/designateclient"
+	"github.com/openstack-k8s-operators/lib-common/modules/common/condition"
+	"github.com/openstack-k8s-operators/lib-common/modules/common/helper"
+	"github.com/openstack-k8s-operators/lib-common/modules/common/util"
+
+	k8s_errors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+// designateEntry - a custom resource which manages a single entry of a designate
+// EntryCollection, e.g. a DesignateBlacklist or DesignateTLD
+type designateEntry interface {
+	client.Object
+	IsReady() bool
+}
+
+// designateEntryStatus - the status fields of a designateEntry
+type designateEntryStatus struct {
+	Conditions         *condition.Conditions
+	ObservedGeneration *int64
+	// ID - ID of the entry in designate
+	ID *string
+	// Adopted - the entry existed in designate before the custom resource
+	Adopted *bool
+}
+
+// designateEntryKind - describes a custom resource kind which manages designate entries, everything
+// else is the same for all of them
+type designateEntryKind[T designateEntry] struct {
+	// kind - name of the custom resource kind, e.g. DesignateBlacklist
+	kind string
+	// name - name of an entry in the logs, e.g. blacklist entry
+	name string
+
+	readyCondition    condition.Type
+	readyInitMessage  string
+	readyMessage      string
+	readyErrorMessage string
+	// conflictMessage - message of the ready condition if another instance manages the entry
+	conflictMessage string
+
+	newInstance func() T
+	newList     func() client.ObjectList
+	// designateAPI - returns the name of the DesignateAPI managing the entry
+	designateAPI func(instance T) string
+	// key - returns the attribute of the spec which identifies the entry in designate
+	key func(instance T) string
+	// equalKeys - returns true if both keys identify the same entry in designate
+	equalKeys func(a string, b string) bool
+	// status - returns the status fields of the instance
+	status func(instance T) designateEntryStatus
+	// ensure - creates, adopts or corrects the entry of the instance in designate
+	ensure func(osClient *gophercloud.ServiceClient, instance T) (*designateclient.Entry, bool, error)
+	// delete - deletes the entry with the ID in designate
+	delete func(osClient *gophercloud.ServiceClient, id string) error
+}
+
+// designateEntryReconciler - reconciles the custom resources of a designateEntryKind
+type designateEntryReconciler[T designateEntry] struct {
+	client.Client
+	Kclient kubernetes.Interface
+	Log     logr.Logger
+	Scheme  *runtime.Scheme
+	// NewDesignateClient - creates the designate API clients, defaults to designateclient.NewClient
+	NewDesignateClient DesignateClientFunc
+	kind               designateEntryKind[T]
+}
+
+// Reconcile - creates the entry of the custom resource with the designate API and corrects it if
+// it drifted
+func (r *designateEntryReconciler[T]) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, _err error) {
+	_ = r.Log.WithValues(r.kind.kind, req.NamespacedName)
+
+	// Fetch the instance
+	instance := r.kind.newInstance()
+	err := r.Client.Get(ctx, req.NamespacedName, instance)
+	if err != nil {
+		if k8s_errors.IsNotFound(err) {
+			// Request object not found, could have been deleted after reconcile request.
+			return ctrl.Result{}, nil
+		}
+		// Error reading the object - requeue the request.
+		return ctrl.Result{}, err
+	}
+	status := r.kind.status(instance)
+
+	helper, err := helper.NewHelper(
+		instance,
+		r.Client,
+		r.Kclient,
+		r.Scheme,
+		r.Log,
+	)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Always patch the instance status when exiting this function so we can persist any changes.
+	defer func() {
+		// update the overall status condition if the entry is synced and the current spec got reconciled
+		if instance.IsReady() && *status.ObservedGeneration == instance.GetGeneration() {
+			status.Conditions.MarkTrue(condition.ReadyCondition, condition.ReadyMessage)
+		} else if !instance.IsReady() && status.Conditions.IsTrue(condition.ReadyCondition) {
+			// the entry became unready after it got synced, e.g. because the DesignateAPI went down
+			status.Conditions.MarkFalse(
+				condition.ReadyCondition,
+				condition.RequestedReason,
+				condition.SeverityWarning,
+				designatev1.DesignateNotReadyMessage)
+		}
+
+		err := helper.PatchInstance(ctx, instance)
+		if err != nil {
+			_err = err
+			return
+		}
+	}()
+
+	// If we're not deleting this and the object doesn't have our finalizer, add it.
+	if instance.GetDeletionTimestamp().IsZero() && controllerutil.AddFinalizer(instance, helper.GetFinalizer()) {
+		return ctrl.Result{}, nil
+	}
+
+	//
+	// initialize status
+	//
+	if *status.Conditions == nil {
+		*status.Conditions = condition.Conditions{}
+
+		cl := condition.CreateList(
+			condition.UnknownCondition(condition.InputReadyCondition, condition.InitReason, condition.InputReadyInitMessage),
+			condition.UnknownCondition(r.kind.readyCondition, condition.InitReason, r.kind.readyInitMessage),
+		)
+
+		status.Conditions.Init(&cl)
+
+		// Register overall status immediately to have an early feedback e.g. in the cli
+		return ctrl.Result{}, nil
+	}
+	// Ready refers to the observed generation, reset it until the new spec got reconciled
+	if *status.ObservedGeneration != instance.GetGeneration() && !status.Conditions.IsUnknown(condition.ReadyCondition) {
+		status.Conditions.MarkUnknown(condition.ReadyCondition, condition.InitReason, condition.ReadyInitMessage)
+	}
+
+	if !instance.GetDeletionTimestamp().IsZero() {
+		return r.reconcileDelete(ctx, instance, status, helper)
+	}
+
+	return r.reconcileNormal(ctx, instance, status, helper)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *designateEntryReconciler[T]) SetupWithManager(mgr ctrl.Manager) error {
+	// index the referenced DesignateAPI to find the instances to reconcile on DesignateAPI changes
+	err := mgr.GetFieldIndexer().IndexField(
+		context.Background(),
+		r.kind.newInstance(),
+		designateAPIField,
+		func(rawObj client.Object) []string {
+			return []string{r.kind.designateAPI(rawObj.(T))}
+		})
+	if err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(r.kind.newInstance()).
+		Watches(
+			&source.Kind{Type: &designatev1.DesignateAPI{}},
+			handler.EnqueueRequestsFromMapFunc(r.findObjectsForDesignateAPI),
+		).
+		Complete(r)
+}
+
+// findObjectsForDesignateAPI - returns a reconcile request for every instance managed with the
+// DesignateAPI, e.g. to create the entries as soon as the DesignateAPI is ready
+func (r *designateEntryReconciler[T]) findObjectsForDesignateAPI(designateAPI client.Object) []reconcile.Request {
+	instances := r.kind.newList()
+	err := r.Client.List(
+		context.Background(),
+		instances,
+		client.InNamespace(designateAPI.GetNamespace()),
+		client.MatchingFields{designateAPIField: designateAPI.GetName()},
+	)
+	if err != nil {
+		r.Log.Error(err, fmt.Sprintf("Unable to list %ss of DesignateAPI %s", r.kind.kind, designateAPI.GetName()))
+		return []reconcile.Request{}
+	}
+	items, err := meta.ExtractList(instances)
+	if err != nil {
+		r.Log.Error(err, fmt.Sprintf("Unable to list %ss of DesignateAPI %s", r.kind.kind, designateAPI.GetName()))
+		return []reconcile.Request{}
+	}
+
+	requests := make([]reconcile.Request, len(items))
+	for i, item := range items {
+		instance := item.(client.Object)
+		requests[i] = reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      instance.GetName(),
+				Namespace: instance.GetNamespace(),
+			},
+		}
+	}
+	return requests
+}
+
+func (r *designateEntryReconciler[T]) reconcileDelete(ctx context.Context, instance T, status designateEntryStatus, helper *helper.Helper) (ctrl.Result, error) {
+	util.LogForObject(helper, fmt.Sprintf("Reconciling %s delete", r.kind.name), instance)
+
+	// entries which existed before the instance are left in designate
+	if *status.ID != "" && !*status.Adopted {
+		// without the DesignateAPI the entry can not be deleted, which is also the case when the
+		// whole namespace gets deleted
+		designateAPIName := r.kind.designateAPI(instance)
+		designateAPI := &designatev1.DesignateAPI{}
+		err := r.Client.Get(ctx, types.NamespacedName{Namespace: instance.GetNamespace(), Name: designateAPIName}, designateAPI)
+		if err != nil && !k8s_errors.IsNotFound(err) {
+			return ctrl.Result{}, err
+		}
+		if k8s_errors.IsNotFound(err) || !designateAPI.DeletionTimestamp.IsZero() {
+			util.LogForObject(helper, fmt.Sprintf("DesignateAPI %s is gone, %s %s is not deleted in designate", designateAPIName, r.kind.name, r.kind.key(instance)), instance)
+		} else {
+			osClient, ctrlResult, err := getDesignateClient(ctx, helper, r.NewDesignateClient, instance.GetNamespace(), designateAPIName)
+			if err != nil {
+				return ctrlResult, err
+			} else if (ctrlResult != ctrl.Result{}) {
+				return ctrlResult, nil
+			}
+
+			err = r.kind.delete(osClient, *status.ID)
+			if err != nil {
+				return ctrl.Result{}, err
+			}
+			util.LogForObject(helper, fmt.Sprintf("Deleted %s %s", r.kind.name, r.kind.key(instance)), instance)
+		}
+	}
+
+	controllerutil.RemoveFinalizer(instance, helper.GetFinalizer())
+	util.LogForObject(helper, fmt.Sprintf("Reconciled %s delete successfully", r.kind.name), instance)
+
+	return ctrl.Result{}, nil
+}
+
+func (r *designateEntryReconciler[T]) reconcileNormal(ctx context.Context, instance T, status designateEntryStatus, helper *helper.Helper) (ctrl.Result, error) {
+	designateAPIName := r.kind.designateAPI(instance)
+	osClient, ctrlResult, err := getDesignateClient(ctx, helper, r.NewDesignateClient, instance.GetNamespace(), designateAPIName)
+	if err != nil {
+		status.Conditions.Set(condition.FalseCondition(
+			condition.InputReadyCondition,
+			condition.ErrorReason,
+			condition.SeverityWarning,
+			condition.InputReadyErrorMessage,
+			err.Error()))
+		return ctrlResult, err
+	} else if (ctrlResult != ctrl.Result{}) {
+		status.Conditions.Set(condition.FalseCondition(
+			condition.InputReadyCondition,
+			condition.RequestedReason,
+			condition.SeverityInfo,
+			designatev1.DesignateAPIWaitingMessage,
+			designateAPIName))
+		return ctrlResult, nil
+	}
+	status.Conditions.MarkTrue(condition.InputReadyCondition, condition.InputReadyMessage)
+
+	// an entry is managed by a single instance, otherwise deleting one of them would delete the
+	// entry the others still manage
+	if *status.ID == "" {
+		owner, err := r.getEntryOwner(ctx, instance)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if owner != "" {
+			status.Conditions.Set(condition.FalseCondition(
+				r.kind.readyCondition,
+				condition.ErrorReason,
+				condition.SeverityWarning,
+				r.kind.conflictMessage,
+				r.kind.key(instance),
+				owner))
+			return ctrl.Result{RequeueAfter: zoneResyncInterval}, nil
+		}
+	}
+
+	entry, adopted, err := r.kind.ensure(osClient, instance)
+	if err != nil {
+		status.Conditions.Set(condition.FalseCondition(
+			r.kind.readyCondition,
+			condition.ErrorReason,
+			condition.SeverityWarning,
+			r.kind.readyErrorMessage,
+			designateclient.ErrorMessage(err)))
+		return ctrl.Result{}, err
+	}
+	if *status.ID != entry.ID {
+		if adopted {
+			util.LogForObject(helper, fmt.Sprintf("Adopted %s %s with ID %s", r.kind.name, entry.Key, entry.ID), instance)
+		} else {
+			util.LogForObject(helper, fmt.Sprintf("The %s %s has ID %s", r.kind.name, entry.Key, entry.ID), instance)
+		}
+		*status.Adopted = adopted
+	}
+	*status.ID = entry.ID
+
+	*status.ObservedGeneration = instance.GetGeneration()
+	status.Conditions.MarkTrue(r.kind.readyCondition, r.kind.readyMessage)
+
+	// resync to recreate the entry if it got deleted with the designate API directly
+	return ctrl.Result{RequeueAfter: zoneResyncInterval}, nil
+}
+
+// getEntryOwner - returns the name of another instance of the DesignateAPI which manages the entry
+// with the same key, or an empty string if there is none
+func (r *designateEntryReconciler[T]) getEntryOwner(ctx context.Context, instance T) (string, error) {
+	instances := r.kind.newList()
+	err := r.Client.List(
+		ctx,
+		instances,
+		client.InNamespace(instance.GetNamespace()),
+		client.MatchingFields{designateAPIField: r.kind.designateAPI(instance)},
+	)
+	if err != nil {
+		return "", err
+	}
+	items, err := meta.ExtractList(instances)
+	if err != nil {
+		return "", err
+	}
+
+	for _, item := range items {
+		other := item.(T)
+		if other.GetUID() != instance.GetUID() && r.kind.equalKeys(r.kind.key(other), r.kind.key(instance)) &&
+			*r.kind.status(other).ID != "" && other.GetDeletionTimestamp().IsZero() {
+			return other.GetName(), nil
+		}
+	}
+	return "", nil
+}
@@ -0,0 +1,290 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	designatev1 "github.com/openstack-k8s-operators/designate-operator/api/v1beta1"
+	"github.com/openstack-k8s-operators/designate-operator/pkg/designatequota"
+	"github.com/openstack-k8s-operators/lib-common/modules/common/condition"
+	"github.com/openstack-k8s-operators/lib-common/modules/common/helper"
+	"github.com/openstack-k8s-operators/lib-common/modules/common/util"
+
+	k8s_errors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+// DesignateQuotaReconciler reconciles a DesignateQuota object
+type DesignateQuotaReconciler struct {
+	client.Client
+	Kclient kubernetes.Interface
+	Log     logr.Logger
+	Scheme  *runtime.Scheme
+	// NewDesignateClient - creates the designate API clients, defaults to designateclient.NewClient
+	NewDesignateClient DesignateClientFunc
+}
+
+// +kubebuilder:rbac:groups=designate.openstack.org,resources=designatequotas,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=designate.openstack.org,resources=designatequotas/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=designate.openstack.org,resources=designatequotas/finalizers,verbs=update
+// +kubebuilder:rbac:groups=designate.openstack.org,resources=designateapis,verbs=get;list;watch;
+// +kubebuilder:rbac:groups=keystone.openstack.org,resources=keystoneapis,verbs=get;list;watch;
+// +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;
+
+// Reconcile - sets the quotas of the project of the DesignateQuota with the designate admin API and
+// reports the applied quotas and the usage
+func (r *DesignateQuotaReconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, _err error) {
+	_ = r.Log.WithValues("designatequota", req.NamespacedName)
+
+	// Fetch the DesignateQuota instance
+	instance := &designatev1.DesignateQuota{}
+	err := r.Client.Get(ctx, req.NamespacedName, instance)
+	if err != nil {
+		if k8s_errors.IsNotFound(err) {
+			// Request object not found, could have been deleted after reconcile request.
+			return ctrl.Result{}, nil
+		}
+		// Error reading the object - requeue the request.
+		return ctrl.Result{}, err
+	}
+
+	helper, err := helper.NewHelper(
+		instance,
+		r.Client,
+		r.Kclient,
+		r.Scheme,
+		r.Log,
+	)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Always patch the instance status when exiting this function so we can persist any changes.
+	defer func() {
+		// update the overall status condition if the quotas are applied and the current spec got reconciled
+		if instance.IsReady() && instance.Status.ObservedGeneration == instance.Generation {
+			instance.Status.Conditions.MarkTrue(condition.ReadyCondition, condition.ReadyMessage)
+		} else if !instance.IsReady() && instance.Status.Conditions.IsTrue(condition.ReadyCondition) {
+			// the quotas became unready after they got applied, e.g. because the DesignateAPI went down
+			instance.Status.Conditions.MarkFalse(
+				condition.ReadyCondition,
+				condition.RequestedReason,
+				condition.SeverityWarning,
+				designatev1.DesignateNotReadyMessage)
+		}
+
+		err := helper.PatchInstance(ctx, instance)
+		if err != nil {
+			_err = err
+			return
+		}
+	}()
+
+	// If we're not deleting this and the object doesn't have our finalizer, add it.
+	if instance.DeletionTimestamp.IsZero() && controllerutil.AddFinalizer(instance, helper.GetFinalizer()) {
+		return ctrl.Result{}, nil
+	}
+
+	//
+	// initialize status
+	//
+	if instance.Status.Conditions == nil {
+		instance.Status.Conditions = condition.Conditions{}
+
+		cl := condition.CreateList(
+			condition.UnknownCondition(condition.InputReadyCondition, condition.InitReason, condition.InputReadyInitMessage),
+			condition.UnknownCondition(designatev1.DesignateQuotaReadyCondition, condition.InitReason, designatev1.DesignateQuotaReadyInitMessage),
+		)
+
+		instance.Status.Conditions.Init(&cl)
+
+		// Register overall status immediately to have an early feedback e.g. in the cli
+		return ctrl.Result{}, nil
+	}
+	// Ready refers to the observed generation, reset it until the new spec got reconciled
+	if instance.Status.ObservedGeneration != instance.Generation && !instance.Status.Conditions.IsUnknown(condition.ReadyCondition) {
+		instance.Status.Conditions.MarkUnknown(condition.ReadyCondition, condition.InitReason, condition.ReadyInitMessage)
+	}
+
+	if !instance.DeletionTimestamp.IsZero() {
+		return r.reconcileDelete(ctx, instance, helper)
+	}
+
+	return r.reconcileNormal(ctx, instance, helper)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *DesignateQuotaReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// index the referenced DesignateAPI to find the DesignateQuotas to reconcile on DesignateAPI changes,
+	// e.g. of the default quotas
+	err := mgr.GetFieldIndexer().IndexField(
+		context.Background(),
+		&designatev1.DesignateQuota{},
+		designateAPIField,
+		func(rawObj client.Object) []string {
+			instance := rawObj.(*designatev1.DesignateQuota)
+			return []string{instance.Spec.DesignateAPI}
+		})
+	if err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&designatev1.DesignateQuota{}).
+		Watches(
+			&source.Kind{Type: &designatev1.DesignateAPI{}},
+			handler.EnqueueRequestsFromMapFunc(r.findObjectsForDesignateAPI),
+		).
+		Complete(r)
+}
+
+// findObjectsForDesignateAPI - returns a reconcile request for every DesignateQuota managed with the
+// DesignateAPI
+func (r *DesignateQuotaReconciler) findObjectsForDesignateAPI(designateAPI client.Object) []reconcile.Request {
+	instances := &designatev1.DesignateQuotaList{}
+	err := r.Client.List(
+		context.Background(),
+		instances,
+		client.InNamespace(designateAPI.GetNamespace()),
+		client.MatchingFields{designateAPIField: designateAPI.GetName()},
+	)
+	if err != nil {
+		r.Log.Error(err, fmt.Sprintf("Unable to list DesignateQuotas of DesignateAPI %s", designateAPI.GetName()))
+		return []reconcile.Request{}
+	}
+
+	requests := make([]reconcile.Request, len(instances.Items))
+	for i, instance := range instances.Items {
+		requests[i] = reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      instance.GetName(),
+				Namespace: instance.GetNamespace(),
+			},
+		}
+	}
+	return requests
+}
+
+func (r *DesignateQuotaReconciler) reconcileDelete(ctx context.Context, instance *designatev1.DesignateQuota, helper *helper.Helper) (ctrl.Result, error) {
+	util.LogForObject(helper, "Reconciling quota delete", instance)
+
+	if instance.Status.Quotas != nil {
+		// without the DesignateAPI the quotas can not be reset, which is also the case when the
+		// whole namespace gets deleted
+		designateAPI := &designatev1.DesignateAPI{}
+		err := r.Client.Get(ctx, types.NamespacedName{Namespace: instance.Namespace, Name: instance.Spec.DesignateAPI}, designateAPI)
+		if err != nil && !k8s_errors.IsNotFound(err) {
+			return ctrl.Result{}, err
+		}
+		if k8s_errors.IsNotFound(err) || !designateAPI.DeletionTimestamp.IsZero() {
+			util.LogForObject(helper, fmt.Sprintf("DesignateAPI %s is gone, quotas of project %s are not reset in designate", instance.Spec.DesignateAPI, instance.Spec.ProjectID), instance)
+		} else {
+			osClient, ctrlResult, err := getDesignateClient(ctx, helper, r.NewDesignateClient, instance.Namespace, instance.Spec.DesignateAPI)
+			if err != nil {
+				return ctrlResult, err
+			} else if (ctrlResult != ctrl.Result{}) {
+				return ctrlResult, nil
+			}
+
+			err = designatequota.ResetQuotas(osClient, instance.Spec.ProjectID)
+			if err != nil {
+				return ctrl.Result{}, err
+			}
+			util.LogForObject(helper, fmt.Sprintf("Reset quotas of project %s", instance.Spec.ProjectID), instance)
+		}
+	}
+
+	controllerutil.RemoveFinalizer(instance, helper.GetFinalizer())
+	util.LogForObject(helper, "Reconciled quota delete successfully", instance)
+
+	return ctrl.Result{}, nil
+}
+
+func (r *DesignateQuotaReconciler) reconcileNormal(ctx context.Context, instance *designatev1.DesignateQuota, helper *helper.Helper) (ctrl.Result, error) {
+	osClient, ctrlResult, err := getDesignateClient(ctx, helper, r.NewDesignateClient, instance.Namespace, instance.Spec.DesignateAPI)
+	if err != nil {
+		instance.Status.Conditions.Set(condition.FalseCondition(
+			condition.InputReadyCondition,
+			condition.ErrorReason,
+			condition.SeverityWarning,
+			condition.InputReadyErrorMessage,
+			err.Error()))
+		return ctrlResult, err
+	} else if (ctrlResult != ctrl.Result{}) {
+		instance.Status.Conditions.Set(condition.FalseCondition(
+			condition.InputReadyCondition,
+			condition.RequestedReason,
+			condition.SeverityInfo,
+			designatev1.DesignateAPIWaitingMessage,
+			instance.Spec.DesignateAPI))
+		return ctrlResult, nil
+	}
+
+	// the quotas which are not overridden are the defaults of the DesignateAPI
+	designateAPI := &designatev1.DesignateAPI{}
+	err = r.Client.Get(ctx, types.NamespacedName{Namespace: instance.Namespace, Name: instance.Spec.DesignateAPI}, designateAPI)
+	if err != nil {
+		instance.Status.Conditions.Set(condition.FalseCondition(
+			condition.InputReadyCondition,
+			condition.ErrorReason,
+			condition.SeverityWarning,
+			condition.InputReadyErrorMessage,
+			err.Error()))
+		return ctrl.Result{}, err
+	}
+	instance.Status.Conditions.MarkTrue(condition.InputReadyCondition, condition.InputReadyMessage)
+
+	quotas, err := designatequota.EnsureQuotas(osClient, instance, designateAPI.Spec.Quotas)
+	if err != nil {
+		instance.Status.Conditions.Set(condition.FalseCondition(
+			designatev1.DesignateQuotaReadyCondition,
+			condition.ErrorReason,
+			condition.SeverityWarning,
+			designatev1.DesignateQuotaReadyErrorMessage,
+			err.Error()))
+		return ctrl.Result{}, err
+	}
+	instance.Status.Quotas = quotas.Values()
+
+	zones, err := designatequota.CountZones(osClient, instance.Spec.ProjectID)
+	if err != nil {
+		instance.Status.Conditions.Set(condition.FalseCondition(
+			designatev1.DesignateQuotaReadyCondition,
+			condition.ErrorReason,
+			condition.SeverityWarning,
+			designatev1.DesignateQuotaReadyErrorMessage,
+			err.Error()))
+		return ctrl.Result{}, err
+	}
+	instance.Status.Usage = &designatev1.DesignateQuotaUsage{Zones: int32(zones)}
+
+	instance.Status.ObservedGeneration = instance.Generation
+	instance.Status.Conditions.MarkTrue(designatev1.DesignateQuotaReadyCondition, designatev1.DesignateQuotaReadyMessage)
+
+	// read the quotas and usage back periodically to correct changes done with the designate API directly
+	return ctrl.Result{RequeueAfter: zoneResyncInterval}, nil
+}
@@ -0,0 +1,119 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	designatev1 "github.com/openstack-k8s-operators/designate-operator/api/v1beta1"
+	"github.com/openstack-k8s-operators/designate-operator/pkg/designateclient/fake"
+	"github.com/openstack-k8s-operators/lib-common/modules/common/condition"
+
+	corev1 "k8s.io/api/core/v1"
+	k8s_errors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ = Describe("DesignateQuota controller", func() {
+	var namespace string
+	var name types.NamespacedName
+	var projectID string
+	var spec designatev1.DesignateQuotaSpec
+
+	BeforeEach(func() {
+		namespace = CreateNamespace()
+		name = types.NamespacedName{Namespace: namespace, Name: "demo-project"}
+		// the fake server is shared by all tests, every test manages its own project
+		projectID = "project-" + namespace
+		zones := int32(50)
+		spec = designatev1.DesignateQuotaSpec{
+			DesignateAPI: "designate",
+			ProjectID:    projectID,
+			Zones:        &zones,
+		}
+	})
+
+	When("the DesignateAPI does not exist", func() {
+		BeforeEach(func() {
+			CreateObject(name, &designatev1.DesignateQuota{Spec: spec})
+		})
+
+		It("waits for the DesignateAPI", func() {
+			Eventually(func(g Gomega) {
+				c := GetObject[designatev1.DesignateQuota](name).Status.Conditions.Get(condition.InputReadyCondition)
+				g.Expect(c).NotTo(BeNil())
+				g.Expect(c.Status).To(Equal(corev1.ConditionFalse))
+				g.Expect(c.Reason).To(Equal(condition.RequestedReason))
+			}, timeout, interval).Should(Succeed())
+		})
+	})
+
+	When("the DesignateAPI is ready", func() {
+		BeforeEach(func() {
+			CreateReadyDesignateAPI(types.NamespacedName{Namespace: namespace, Name: "designate"})
+			designateServer.AddZone(fake.Zone{Name: "example-" + namespace + ".com.", ProjectID: projectID})
+			CreateObject(name, &designatev1.DesignateQuota{Spec: spec})
+		})
+
+		It("applies, reports and resets the quotas of the project", func() {
+			Eventually(func(g Gomega) {
+				instance := GetObject[designatev1.DesignateQuota](name)
+				g.Expect(instance.Status.Conditions.IsTrue(condition.ReadyCondition)).To(BeTrue())
+				g.Expect(instance.Status.Quotas).NotTo(BeNil())
+				g.Expect(instance.Status.Quotas.Zones).To(Equal(int32(50)))
+				g.Expect(instance.Status.Quotas.ZoneRecordsets).To(Equal(int32(500)))
+				g.Expect(instance.Status.Usage).NotTo(BeNil())
+				g.Expect(instance.Status.Usage.Zones).To(Equal(int32(1)))
+			}, timeout, interval).Should(Succeed())
+			Expect(designateServer.GetQuotas(projectID).Zones).To(Equal(50))
+
+			Eventually(func(g Gomega) {
+				instance := GetObject[designatev1.DesignateQuota](name)
+				recordsetRecords := int32(100)
+				instance.Spec.RecordsetRecords = &recordsetRecords
+				g.Expect(k8sClient.Update(ctx, instance)).To(Succeed())
+			}, timeout, interval).Should(Succeed())
+			Eventually(func() int {
+				return designateServer.GetQuotas(projectID).RecordsetRecords
+			}, timeout, interval).Should(Equal(100))
+
+			Expect(k8sClient.Delete(ctx, GetObject[designatev1.DesignateQuota](name))).To(Succeed())
+			Eventually(func() bool {
+				return k8s_errors.IsNotFound(k8sClient.Get(ctx, name, &designatev1.DesignateQuota{}))
+			}, timeout, interval).Should(BeTrue())
+			Expect(designateServer.GetQuotas(projectID)).To(Equal(fake.DefaultQuotas))
+		})
+
+		It("is not ready anymore when the DesignateAPI goes down", func() {
+			Eventually(func(g Gomega) {
+				g.Expect(GetObject[designatev1.DesignateQuota](name).Status.Conditions.IsTrue(condition.ReadyCondition)).To(BeTrue())
+			}, timeout, interval).Should(Succeed())
+
+			Eventually(func(g Gomega) {
+				deployment := GetDeployment(types.NamespacedName{Namespace: namespace, Name: "designate"})
+				deployment.Status.ReadyReplicas = 0
+				g.Expect(k8sClient.Status().Update(ctx, deployment)).To(Succeed())
+			}, timeout, interval).Should(Succeed())
+
+			Eventually(func(g Gomega) {
+				instance := GetObject[designatev1.DesignateQuota](name)
+				g.Expect(instance.Status.Conditions.IsFalse(condition.InputReadyCondition)).To(BeTrue())
+				g.Expect(instance.Status.Conditions.IsFalse(condition.ReadyCondition)).To(BeTrue())
+			}, timeout, interval).Should(Succeed())
+		})
+	})
+})
@@ -0,0 +1,373 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	designatev1 "github.com/openstack-k8s-operators/designate-operator/api/v1beta1"
+	"github.com/openstack-k8s-operators/designate-operator/pkg/designateclient"
+	"github.com/openstack-k8s-operators/designate-operator/pkg/designaterecordset"
+	"github.com/openstack-k8s-operators/lib-common/modules/common/condition"
+	"github.com/openstack-k8s-operators/lib-common/modules/common/helper"
+	"github.com/openstack-k8s-operators/lib-common/modules/common/util"
+
+	k8s_errors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+const zoneRefField = ".spec.zoneRef"
+
+// DesignateRecordSetReconciler reconciles a DesignateRecordSet object
+type DesignateRecordSetReconciler struct {
+	client.Client
+	Kclient kubernetes.Interface
+	Log     logr.Logger
+	Scheme  *runtime.Scheme
+	// NewDesignateClient - creates the designate API clients, defaults to designateclient.NewClient
+	NewDesignateClient DesignateClientFunc
+}
+
+// +kubebuilder:rbac:groups=designate.openstack.org,resources=designaterecordsets,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=designate.openstack.org,resources=designaterecordsets/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=designate.openstack.org,resources=designaterecordsets/finalizers,verbs=update
+// +kubebuilder:rbac:groups=designate.openstack.org,resources=designatezones,verbs=get;list;watch;
+// +kubebuilder:rbac:groups=designate.openstack.org,resources=designateapis,verbs=get;list;watch;
+// +kubebuilder:rbac:groups=keystone.openstack.org,resources=keystoneapis,verbs=get;list;watch;
+// +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;
+
+// Reconcile - creates the recordset of the DesignateRecordSet in the zone of the referenced
+// DesignateZone and corrects the records if they drifted in designate
+func (r *DesignateRecordSetReconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, _err error) {
+	_ = r.Log.WithValues("designaterecordset", req.NamespacedName)
+
+	// Fetch the DesignateRecordSet instance
+	instance := &designatev1.DesignateRecordSet{}
+	err := r.Client.Get(ctx, req.NamespacedName, instance)
+	if err != nil {
+		if k8s_errors.IsNotFound(err) {
+			// Request object not found, could have been deleted after reconcile request.
+			return ctrl.Result{}, nil
+		}
+		// Error reading the object - requeue the request.
+		return ctrl.Result{}, err
+	}
+
+	helper, err := helper.NewHelper(
+		instance,
+		r.Client,
+		r.Kclient,
+		r.Scheme,
+		r.Log,
+	)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Always patch the instance status when exiting this function so we can persist any changes.
+	defer func() {
+		// update the overall status condition if the recordset is ready and the current spec got reconciled
+		if instance.IsReady() && instance.Status.ObservedGeneration == instance.Generation {
+			instance.Status.Conditions.MarkTrue(condition.ReadyCondition, condition.ReadyMessage)
+		} else if !instance.IsReady() && instance.Status.Conditions.IsTrue(condition.ReadyCondition) {
+			// the recordset became unready after it was ready, e.g. because designate failed to update it
+			instance.Status.Conditions.MarkFalse(
+				condition.ReadyCondition,
+				condition.RequestedReason,
+				condition.SeverityWarning,
+				designatev1.DesignateNotReadyMessage)
+		}
+
+		err := helper.PatchInstance(ctx, instance)
+		if err != nil {
+			_err = err
+			return
+		}
+	}()
+
+	// If we're not deleting this and the object doesn't have our finalizer, add it.
+	if instance.DeletionTimestamp.IsZero() && controllerutil.AddFinalizer(instance, helper.GetFinalizer()) {
+		return ctrl.Result{}, nil
+	}
+
+	//
+	// initialize status
+	//
+	if instance.Status.Conditions == nil {
+		instance.Status.Conditions = condition.Conditions{}
+
+		cl := condition.CreateList(
+			condition.UnknownCondition(condition.InputReadyCondition, condition.InitReason, condition.InputReadyInitMessage),
+			condition.UnknownCondition(designatev1.DesignateRecordSetReadyCondition, condition.InitReason, designatev1.DesignateRecordSetReadyInitMessage),
+		)
+
+		instance.Status.Conditions.Init(&cl)
+
+		// Register overall status immediately to have an early feedback e.g. in the cli
+		return ctrl.Result{}, nil
+	}
+	// Ready refers to the observed generation, reset it until the new spec got reconciled
+	if instance.Status.ObservedGeneration != instance.Generation && !instance.Status.Conditions.IsUnknown(condition.ReadyCondition) {
+		instance.Status.Conditions.MarkUnknown(condition.ReadyCondition, condition.InitReason, condition.ReadyInitMessage)
+	}
+
+	if !instance.DeletionTimestamp.IsZero() {
+		return r.reconcileDelete(ctx, instance, helper)
+	}
+
+	return r.reconcileNormal(ctx, instance, helper)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *DesignateRecordSetReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// index the referenced DesignateZone to find the DesignateRecordSets to reconcile on DesignateZone changes
+	err := mgr.GetFieldIndexer().IndexField(
+		context.Background(),
+		&designatev1.DesignateRecordSet{},
+		zoneRefField,
+		func(rawObj client.Object) []string {
+			instance := rawObj.(*designatev1.DesignateRecordSet)
+			return []string{instance.Spec.ZoneRef}
+		})
+	if err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&designatev1.DesignateRecordSet{}).
+		Watches(
+			&source.Kind{Type: &designatev1.DesignateZone{}},
+			handler.EnqueueRequestsFromMapFunc(r.findObjectsForDesignateZone),
+		).
+		Complete(r)
+}
+
+// findObjectsForDesignateZone - returns a reconcile request for every DesignateRecordSet of the
+// DesignateZone, e.g. to create the recordsets as soon as the zone got created in designate
+func (r *DesignateRecordSetReconciler) findObjectsForDesignateZone(zone client.Object) []reconcile.Request {
+	instances := &designatev1.DesignateRecordSetList{}
+	err := r.Client.List(
+		context.Background(),
+		instances,
+		client.InNamespace(zone.GetNamespace()),
+		client.MatchingFields{zoneRefField: zone.GetName()},
+	)
+	if err != nil {
+		r.Log.Error(err, fmt.Sprintf("Unable to list DesignateRecordSets of DesignateZone %s", zone.GetName()))
+		return []reconcile.Request{}
+	}
+
+	requests := make([]reconcile.Request, len(instances.Items))
+	for i, instance := range instances.Items {
+		requests[i] = reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      instance.GetName(),
+				Namespace: instance.GetNamespace(),
+			},
+		}
+	}
+	return requests
+}
+
+func (r *DesignateRecordSetReconciler) reconcileDelete(ctx context.Context, instance *designatev1.DesignateRecordSet, helper *helper.Helper) (ctrl.Result, error) {
+	util.LogForObject(helper, "Reconciling recordset delete", instance)
+
+	// the DesignateRecordSet fully manages the recordset, also one which it adopted
+	if instance.Status.RecordSetID != "" {
+		// the recordset is gone with the zone, and without the DesignateAPI it can not be deleted,
+		// which is also the case when the whole namespace gets deleted
+		zone := &designatev1.DesignateZone{}
+		err := r.Client.Get(ctx, types.NamespacedName{Namespace: instance.Namespace, Name: instance.Spec.ZoneRef}, zone)
+		if err != nil && !k8s_errors.IsNotFound(err) {
+			return ctrl.Result{}, err
+		}
+		designateAPI := &designatev1.DesignateAPI{}
+		if err == nil {
+			err = r.Client.Get(ctx, types.NamespacedName{Namespace: instance.Namespace, Name: zone.Spec.DesignateAPI}, designateAPI)
+			if err != nil && !k8s_errors.IsNotFound(err) {
+				return ctrl.Result{}, err
+			}
+		}
+		if k8s_errors.IsNotFound(err) || !designateAPI.DeletionTimestamp.IsZero() {
+			util.LogForObject(helper, fmt.Sprintf("DesignateZone %s or its DesignateAPI is gone, recordset %s is not deleted in designate", instance.Spec.ZoneRef, instance.Spec.Name), instance)
+		} else {
+			osClient, ctrlResult, err := getDesignateClient(ctx, helper, r.NewDesignateClient, instance.Namespace, zone.Spec.DesignateAPI)
+			if err != nil {
+				return ctrlResult, err
+			} else if (ctrlResult != ctrl.Result{}) {
+				return ctrlResult, nil
+			}
+
+			err = designaterecordset.DeleteRecordSet(osClient, instance.Status.ZoneID, instance.Status.RecordSetID)
+			if err != nil {
+				return ctrl.Result{}, err
+			}
+			util.LogForObject(helper, fmt.Sprintf("Deleted recordset %s", instance.Spec.Name), instance)
+		}
+	}
+
+	controllerutil.RemoveFinalizer(instance, helper.GetFinalizer())
+	util.LogForObject(helper, "Reconciled recordset delete successfully", instance)
+
+	return ctrl.Result{}, nil
+}
+
+func (r *DesignateRecordSetReconciler) reconcileNormal(ctx context.Context, instance *designatev1.DesignateRecordSet, helper *helper.Helper) (ctrl.Result, error) {
+	//
+	// the zone has to exist in designate before records can get added
+	//
+	zone := &designatev1.DesignateZone{}
+	err := r.Client.Get(ctx, types.NamespacedName{Namespace: instance.Namespace, Name: instance.Spec.ZoneRef}, zone)
+	if err != nil && !k8s_errors.IsNotFound(err) {
+		instance.Status.Conditions.Set(condition.FalseCondition(
+			condition.InputReadyCondition,
+			condition.ErrorReason,
+			condition.SeverityWarning,
+			condition.InputReadyErrorMessage,
+			err.Error()))
+		return ctrl.Result{}, err
+	}
+	if k8s_errors.IsNotFound(err) || zone.Status.ZoneID == "" {
+		instance.Status.Conditions.Set(condition.FalseCondition(
+			condition.InputReadyCondition,
+			condition.RequestedReason,
+			condition.SeverityInfo,
+			designatev1.DesignateZoneWaitingMessage,
+			instance.Spec.ZoneRef))
+		return ctrl.Result{RequeueAfter: time.Second * 10}, nil
+	}
+
+	osClient, ctrlResult, err := getDesignateClient(ctx, helper, r.NewDesignateClient, instance.Namespace, zone.Spec.DesignateAPI)
+	if err != nil {
+		instance.Status.Conditions.Set(condition.FalseCondition(
+			condition.InputReadyCondition,
+			condition.ErrorReason,
+			condition.SeverityWarning,
+			condition.InputReadyErrorMessage,
+			err.Error()))
+		return ctrlResult, err
+	} else if (ctrlResult != ctrl.Result{}) {
+		instance.Status.Conditions.Set(condition.FalseCondition(
+			condition.InputReadyCondition,
+			condition.RequestedReason,
+			condition.SeverityInfo,
+			designatev1.DesignateAPIWaitingMessage,
+			zone.Spec.DesignateAPI))
+		return ctrlResult, nil
+	}
+	instance.Status.Conditions.MarkTrue(condition.InputReadyCondition, condition.InputReadyMessage)
+
+	// a recordset is managed by a single DesignateRecordSet
+	if instance.Status.RecordSetID == "" {
+		owner, err := r.getRecordSetOwner(ctx, instance)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if owner != "" {
+			instance.Status.Conditions.Set(condition.FalseCondition(
+				designatev1.DesignateRecordSetReadyCondition,
+				condition.ErrorReason,
+				condition.SeverityWarning,
+				designatev1.DesignateRecordSetConflictMessage,
+				instance.Spec.Name,
+				owner))
+			return ctrl.Result{RequeueAfter: zoneResyncInterval}, nil
+		}
+	}
+
+	recordSet, adopted, err := designaterecordset.EnsureRecordSet(osClient, zone.Status.ZoneID, instance)
+	if err != nil {
+		instance.Status.Conditions.Set(condition.FalseCondition(
+			designatev1.DesignateRecordSetReadyCondition,
+			condition.ErrorReason,
+			condition.SeverityWarning,
+			designatev1.DesignateRecordSetReadyErrorMessage,
+			err.Error()))
+		return ctrl.Result{}, err
+	}
+	if instance.Status.RecordSetID != recordSet.ID {
+		if adopted {
+			util.LogForObject(helper, fmt.Sprintf("Adopted recordset %s with ID %s", recordSet.Name, recordSet.ID), instance)
+		} else {
+			util.LogForObject(helper, fmt.Sprintf("Recordset %s has ID %s", recordSet.Name, recordSet.ID), instance)
+		}
+		instance.Status.Adopted = adopted
+	}
+	instance.Status.ZoneID = recordSet.ZoneID
+	instance.Status.RecordSetID = recordSet.ID
+	instance.Status.RecordSetStatus = recordSet.Status
+	instance.Status.Action = recordSet.Action
+
+	// all steps of the current spec got reconciled, designate processes the changes asynchronously
+	instance.Status.ObservedGeneration = instance.Generation
+
+	switch recordSet.Status {
+	case designateclient.StatusActive:
+		instance.Status.Conditions.MarkTrue(designatev1.DesignateRecordSetReadyCondition, designatev1.DesignateRecordSetReadyMessage)
+	case designateclient.StatusError:
+		instance.Status.Conditions.Set(condition.FalseCondition(
+			designatev1.DesignateRecordSetReadyCondition,
+			condition.ErrorReason,
+			condition.SeverityError,
+			designatev1.DesignateRecordSetReadyErrorMessage,
+			fmt.Sprintf("designate failed to %s the recordset", recordSet.Action)))
+	default:
+		instance.Status.Conditions.Set(condition.FalseCondition(
+			designatev1.DesignateRecordSetReadyCondition,
+			condition.RequestedReason,
+			condition.SeverityInfo,
+			designatev1.DesignateRecordSetReadyRunningMessage,
+			recordSet.Action))
+		return ctrl.Result{RequeueAfter: time.Second * 5}, nil
+	}
+
+	// read the recordset back periodically to correct changes done with the designate API directly
+	return ctrl.Result{RequeueAfter: zoneResyncInterval}, nil
+}
+
+// getRecordSetOwner - returns the name of another DesignateRecordSet of the DesignateZone which manages
+// the recordset with the same name and type, or an empty string if there is none
+func (r *DesignateRecordSetReconciler) getRecordSetOwner(ctx context.Context, instance *designatev1.DesignateRecordSet) (string, error) {
+	instances := &designatev1.DesignateRecordSetList{}
+	err := r.Client.List(
+		ctx,
+		instances,
+		client.InNamespace(instance.Namespace),
+		client.MatchingFields{zoneRefField: instance.Spec.ZoneRef},
+	)
+	if err != nil {
+		return "", err
+	}
+
+	for _, other := range instances.Items {
+		if other.UID != instance.UID && other.Spec.Name == instance.Spec.Name && other.Spec.Type == instance.Spec.Type &&
+			other.Status.RecordSetID != "" && other.DeletionTimestamp.IsZero() {
+			return other.Name, nil
+		}
+	}
+	return "", nil
+}
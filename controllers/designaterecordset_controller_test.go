@@ -0,0 +1,166 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	designatev1 "github.com/openstack-k8s-operators/designate-operator/api/v1beta1"
+	"github.com/openstack-k8s-operators/designate-operator/pkg/designateclient"
+	"github.com/openstack-k8s-operators/designate-operator/pkg/designateclient/fake"
+	"github.com/openstack-k8s-operators/lib-common/modules/common/condition"
+
+	corev1 "k8s.io/api/core/v1"
+	k8s_errors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ = Describe("DesignateRecordSet controller", func() {
+	var namespace string
+	var zoneName types.NamespacedName
+	var name types.NamespacedName
+	var spec designatev1.DesignateRecordSetSpec
+
+	BeforeEach(func() {
+		namespace = CreateNamespace()
+		zoneName = types.NamespacedName{Namespace: namespace, Name: "example-com"}
+		name = types.NamespacedName{Namespace: namespace, Name: "www-example-com"}
+		spec = designatev1.DesignateRecordSetSpec{
+			ZoneRef: zoneName.Name,
+			Name:    "www.example-" + namespace + ".com.",
+			Type:    "A",
+			Records: []string{"192.0.2.10"},
+			TTL:     300,
+		}
+	})
+
+	When("the DesignateZone does not exist", func() {
+		BeforeEach(func() {
+			CreateObject(name, &designatev1.DesignateRecordSet{Spec: spec})
+		})
+
+		It("waits for the DesignateZone", func() {
+			Eventually(func(g Gomega) {
+				c := GetObject[designatev1.DesignateRecordSet](name).Status.Conditions.Get(condition.InputReadyCondition)
+				g.Expect(c).NotTo(BeNil())
+				g.Expect(c.Status).To(Equal(corev1.ConditionFalse))
+				g.Expect(c.Reason).To(Equal(condition.RequestedReason))
+			}, timeout, interval).Should(Succeed())
+		})
+
+		It("gets deleted without the DesignateZone", func() {
+			Eventually(func() []string {
+				return GetObject[designatev1.DesignateRecordSet](name).Finalizers
+			}, timeout, interval).ShouldNot(BeEmpty())
+
+			Expect(k8sClient.Delete(ctx, GetObject[designatev1.DesignateRecordSet](name))).To(Succeed())
+			Eventually(func() bool {
+				return k8s_errors.IsNotFound(k8sClient.Get(ctx, name, &designatev1.DesignateRecordSet{}))
+			}, timeout, interval).Should(BeTrue())
+		})
+	})
+
+	When("the DesignateZone is ready", func() {
+		BeforeEach(func() {
+			CreateReadyDesignateAPI(types.NamespacedName{Namespace: namespace, Name: "designate"})
+			CreateObject(zoneName, &designatev1.DesignateZone{Spec: designatev1.DesignateZoneSpec{
+				DesignateAPI: "designate",
+				Name:         "example-" + namespace + ".com.",
+				Email:        "hostmaster@example.com",
+				TTL:          3600,
+				Type:         designatev1.ZoneTypePrimary,
+			}})
+			CreateObject(name, &designatev1.DesignateRecordSet{Spec: spec})
+		})
+
+		It("creates, corrects and deletes the recordset in designate", func() {
+			var recordSetID string
+			Eventually(func(g Gomega) {
+				instance := GetObject[designatev1.DesignateRecordSet](name)
+				g.Expect(instance.Status.Conditions.IsTrue(condition.ReadyCondition)).To(BeTrue())
+				g.Expect(instance.Status.RecordSetStatus).To(Equal("ACTIVE"))
+				g.Expect(instance.Status.ZoneID).To(Equal(GetObject[designatev1.DesignateZone](zoneName).Status.ZoneID))
+				recordSetID = instance.Status.RecordSetID
+			}, timeout, interval).Should(Succeed())
+			Expect(designateServer.GetRecordSet(recordSetID).Records).To(Equal(spec.Records))
+
+			Eventually(func(g Gomega) {
+				instance := GetObject[designatev1.DesignateRecordSet](name)
+				instance.Spec.Records = []string{"192.0.2.10", "192.0.2.11"}
+				g.Expect(k8sClient.Update(ctx, instance)).To(Succeed())
+			}, timeout, interval).Should(Succeed())
+			Eventually(func() []string {
+				return designateServer.GetRecordSet(recordSetID).Records
+			}, timeout, interval).Should(ConsistOf("192.0.2.10", "192.0.2.11"))
+
+			Expect(k8sClient.Delete(ctx, GetObject[designatev1.DesignateRecordSet](name))).To(Succeed())
+			Eventually(func() bool {
+				return k8s_errors.IsNotFound(k8sClient.Get(ctx, name, &designatev1.DesignateRecordSet{}))
+			}, timeout, interval).Should(BeTrue())
+			Expect(designateServer.GetRecordSet(recordSetID)).To(BeNil())
+		})
+
+		It("does not manage the recordset of another DesignateRecordSet", func() {
+			Eventually(func(g Gomega) {
+				g.Expect(GetObject[designatev1.DesignateRecordSet](name).Status.RecordSetID).NotTo(BeEmpty())
+			}, timeout, interval).Should(Succeed())
+
+			otherName := types.NamespacedName{Namespace: namespace, Name: "www-example-com-other"}
+			CreateObject(otherName, &designatev1.DesignateRecordSet{Spec: spec})
+			Eventually(func(g Gomega) {
+				instance := GetObject[designatev1.DesignateRecordSet](otherName)
+				c := instance.Status.Conditions.Get(designatev1.DesignateRecordSetReadyCondition)
+				g.Expect(c).NotTo(BeNil())
+				g.Expect(c.Status).To(Equal(corev1.ConditionFalse))
+				g.Expect(c.Reason).To(Equal(condition.ErrorReason))
+				g.Expect(instance.Status.RecordSetID).To(BeEmpty())
+			}, timeout, interval).Should(Succeed())
+		})
+
+		It("adopts an existing recordset and deletes it on delete", func() {
+			var zoneID string
+			Eventually(func(g Gomega) {
+				zoneID = GetObject[designatev1.DesignateZone](zoneName).Status.ZoneID
+				g.Expect(zoneID).NotTo(BeEmpty())
+			}, timeout, interval).Should(Succeed())
+			adoptedSpec := spec
+			adoptedSpec.Name = "mail.example-" + namespace + ".com."
+			recordSetID := designateServer.AddRecordSet(fake.RecordSet{
+				ZoneID:  zoneID,
+				Name:    adoptedSpec.Name,
+				Type:    adoptedSpec.Type,
+				Records: adoptedSpec.Records,
+				Status:  designateclient.StatusActive,
+			})
+			adoptedName := types.NamespacedName{Namespace: namespace, Name: "mail-example-com"}
+			CreateObject(adoptedName, &designatev1.DesignateRecordSet{Spec: adoptedSpec})
+
+			Eventually(func(g Gomega) {
+				instance := GetObject[designatev1.DesignateRecordSet](adoptedName)
+				g.Expect(instance.Status.Conditions.IsTrue(condition.ReadyCondition)).To(BeTrue())
+				g.Expect(instance.Status.RecordSetID).To(Equal(recordSetID))
+				g.Expect(instance.Status.Adopted).To(BeTrue())
+			}, timeout, interval).Should(Succeed())
+
+			Expect(k8sClient.Delete(ctx, GetObject[designatev1.DesignateRecordSet](adoptedName))).To(Succeed())
+			Eventually(func() bool {
+				return k8s_errors.IsNotFound(k8sClient.Get(ctx, adoptedName, &designatev1.DesignateRecordSet{}))
+			}, timeout, interval).Should(BeTrue())
+			Expect(designateServer.GetRecordSet(recordSetID)).To(BeNil())
+		})
+	})
+})
@@ -0,0 +1,389 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	designatev1 "github.com/openstack-k8s-operators/designate-operator/api/v1beta1"
+	"github.com/openstack-k8s-operators/designate-operator/pkg/designate"
+	"github.com/openstack-k8s-operators/designate-operator/pkg/designatesink"
+	"github.com/openstack-k8s-operators/lib-common/modules/common"
+	"github.com/openstack-k8s-operators/lib-common/modules/common/condition"
+	"github.com/openstack-k8s-operators/lib-common/modules/common/deployment"
+	"github.com/openstack-k8s-operators/lib-common/modules/common/env"
+	"github.com/openstack-k8s-operators/lib-common/modules/common/helper"
+	"github.com/openstack-k8s-operators/lib-common/modules/common/labels"
+	oko_secret "github.com/openstack-k8s-operators/lib-common/modules/common/secret"
+	"github.com/openstack-k8s-operators/lib-common/modules/common/util"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	k8s_errors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+// transportURLSecretField - index of the DesignateSinks by the name of the referenced transport URL secret
+const transportURLSecretField = ".spec.transportURLSecret"
+
+// DesignateSinkReconciler reconciles a DesignateSink object
+type DesignateSinkReconciler struct {
+	client.Client
+	Kclient kubernetes.Interface
+	Log     logr.Logger
+	Scheme  *runtime.Scheme
+}
+
+// +kubebuilder:rbac:groups=designate.openstack.org,resources=designatesinks,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=designate.openstack.org,resources=designatesinks/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=designate.openstack.org,resources=designatesinks/finalizers,verbs=update
+// +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;create;update;patch;delete;
+// +kubebuilder:rbac:groups=apps,resources=deployments,verbs=get;list;watch;create;update;patch;delete;
+
+// Reconcile - renders the notification handler config of the DesignateSink and runs designate-sink
+func (r *DesignateSinkReconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, _err error) {
+	_ = r.Log.WithValues("designatesink", req.NamespacedName)
+
+	// Fetch the DesignateSink instance
+	instance := &designatev1.DesignateSink{}
+	err := r.Client.Get(ctx, req.NamespacedName, instance)
+	if err != nil {
+		if k8s_errors.IsNotFound(err) {
+			// Request object not found, could have been deleted after reconcile request.
+			// Owned objects are automatically garbage collected.
+			return ctrl.Result{}, nil
+		}
+		// Error reading the object - requeue the request.
+		return ctrl.Result{}, err
+	}
+
+	helper, err := helper.NewHelper(
+		instance,
+		r.Client,
+		r.Kclient,
+		r.Scheme,
+		r.Log,
+	)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Always patch the instance status when exiting this function so we can persist any changes.
+	defer func() {
+		// update the overall status condition if service is ready and the current spec got reconciled
+		if instance.IsReady() && instance.Status.ObservedGeneration == instance.Generation {
+			instance.Status.Conditions.MarkTrue(condition.ReadyCondition, condition.ReadyMessage)
+		} else if !instance.IsReady() && instance.Status.Conditions.IsTrue(condition.ReadyCondition) {
+			// the service became unready after it was ready, e.g. because pods went down
+			instance.Status.Conditions.MarkFalse(
+				condition.ReadyCondition,
+				condition.RequestedReason,
+				condition.SeverityWarning,
+				designatev1.DesignateNotReadyMessage)
+		}
+
+		err := helper.PatchInstance(ctx, instance)
+		if err != nil {
+			_err = err
+			return
+		}
+	}()
+
+	//
+	// initialize status
+	//
+	if instance.Status.Conditions == nil {
+		instance.Status.Conditions = condition.Conditions{}
+
+		cl := condition.CreateList(
+			condition.UnknownCondition(condition.InputReadyCondition, condition.InitReason, condition.InputReadyInitMessage),
+			condition.UnknownCondition(condition.ServiceConfigReadyCondition, condition.InitReason, condition.ServiceConfigReadyInitMessage),
+			condition.UnknownCondition(condition.DeploymentReadyCondition, condition.InitReason, condition.DeploymentReadyInitMessage),
+		)
+
+		instance.Status.Conditions.Init(&cl)
+
+		// Register overall status immediately to have an early feedback e.g. in the cli
+		return ctrl.Result{}, nil
+	}
+	// Ready refers to the observed generation, reset it until the new spec got reconciled
+	if instance.Status.ObservedGeneration != instance.Generation && !instance.Status.Conditions.IsUnknown(condition.ReadyCondition) {
+		instance.Status.Conditions.MarkUnknown(condition.ReadyCondition, condition.InitReason, condition.ReadyInitMessage)
+	}
+	if instance.Status.Hash == nil {
+		instance.Status.Hash = map[string]string{}
+	}
+
+	// The owned objects get garbage collected, there is nothing to clean up on delete
+	if !instance.DeletionTimestamp.IsZero() {
+		return ctrl.Result{}, nil
+	}
+
+	return r.reconcileNormal(ctx, instance, helper)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *DesignateSinkReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// index the referenced transport URL secret to find the DesignateSinks to reconcile on secret changes
+	err := mgr.GetFieldIndexer().IndexField(
+		context.Background(),
+		&designatev1.DesignateSink{},
+		transportURLSecretField,
+		func(rawObj client.Object) []string {
+			instance := rawObj.(*designatev1.DesignateSink)
+			if instance.Spec.TransportURLSecret == "" {
+				return nil
+			}
+			return []string{instance.Spec.TransportURLSecret}
+		})
+	if err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&designatev1.DesignateSink{}).
+		Owns(&corev1.Secret{}).
+		Owns(&appsv1.Deployment{}).
+		Watches(
+			&source.Kind{Type: &corev1.Secret{}},
+			handler.EnqueueRequestsFromMapFunc(r.findObjectsForSecret),
+		).
+		Complete(r)
+}
+
+// findObjectsForSecret - returns a reconcile request for every DesignateSink referencing the secret
+func (r *DesignateSinkReconciler) findObjectsForSecret(secret client.Object) []reconcile.Request {
+	instances := &designatev1.DesignateSinkList{}
+	err := r.Client.List(
+		context.Background(),
+		instances,
+		client.InNamespace(secret.GetNamespace()),
+		client.MatchingFields{transportURLSecretField: secret.GetName()},
+	)
+	if err != nil {
+		r.Log.Error(err, fmt.Sprintf("Unable to list DesignateSinks referencing Secret %s", secret.GetName()))
+		return []reconcile.Request{}
+	}
+
+	requests := make([]reconcile.Request, len(instances.Items))
+	for i, instance := range instances.Items {
+		requests[i] = reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      instance.GetName(),
+				Namespace: instance.GetNamespace(),
+			},
+		}
+	}
+	return requests
+}
+
+func (r *DesignateSinkReconciler) reconcileNormal(ctx context.Context, instance *designatev1.DesignateSink, helper *helper.Helper) (ctrl.Result, error) {
+	r.Log.Info("Reconciling Service")
+
+	configMapVars := make(map[string]env.Setter)
+
+	//
+	// check for the required secret holding the transport_url of the message bus and add its hash to the vars map
+	//
+	transportURLSecret, hash, err := oko_secret.GetSecret(ctx, helper, instance.Spec.TransportURLSecret, instance.Namespace)
+	if err != nil {
+		if k8s_errors.IsNotFound(err) {
+			instance.Status.Conditions.Set(condition.FalseCondition(
+				condition.InputReadyCondition,
+				condition.RequestedReason,
+				condition.SeverityInfo,
+				condition.InputReadyWaitingMessage))
+			return ctrl.Result{RequeueAfter: time.Duration(10) * time.Second}, fmt.Errorf("transport URL secret %s not found", instance.Spec.TransportURLSecret)
+		}
+		instance.Status.Conditions.Set(condition.FalseCondition(
+			condition.InputReadyCondition,
+			condition.ErrorReason,
+			condition.SeverityWarning,
+			condition.InputReadyErrorMessage,
+			err.Error()))
+		return ctrl.Result{}, err
+	}
+	transportURL, ok := transportURLSecret.Data[designate.TransportURLSecretKey]
+	if !ok {
+		err = fmt.Errorf("%s not found in secret %s", designate.TransportURLSecretKey, transportURLSecret.Name)
+		instance.Status.Conditions.Set(condition.FalseCondition(
+			condition.InputReadyCondition,
+			condition.ErrorReason,
+			condition.SeverityWarning,
+			condition.InputReadyErrorMessage,
+			err.Error()))
+		return ctrl.Result{}, err
+	}
+	configMapVars[transportURLSecret.Name] = env.SetValue(hash)
+
+	instance.Status.Conditions.MarkTrue(condition.InputReadyCondition, condition.InputReadyMessage)
+
+	//
+	// create Secrets required as input for the Service and calculate an overall hash of hashes
+	//
+	err = r.generateServiceConfig(ctx, instance, helper, string(transportURL), &configMapVars)
+	if err != nil {
+		instance.Status.Conditions.Set(condition.FalseCondition(
+			condition.ServiceConfigReadyCondition,
+			condition.ErrorReason,
+			condition.SeverityWarning,
+			condition.ServiceConfigReadyErrorMessage,
+			err.Error()))
+		return ctrl.Result{}, err
+	}
+
+	inputHash, hashChanged, err := r.createHashOfInputHashes(instance, configMapVars)
+	if err != nil {
+		return ctrl.Result{}, err
+	} else if hashChanged {
+		// Hash changed and instance status should be updated (which will be done by main defer func),
+		// so we need to return and reconcile again
+		return ctrl.Result{}, nil
+	}
+
+	instance.Status.Conditions.MarkTrue(condition.ServiceConfigReadyCondition, condition.ServiceConfigReadyMessage)
+
+	serviceLabels := map[string]string{
+		common.AppSelector:       designatesink.ComponentName,
+		designate.OwnerNameLabel: instance.Name,
+	}
+
+	// Define a new Deployment object
+	depl := deployment.NewDeployment(
+		designatesink.Deployment(instance, inputHash, serviceLabels),
+		time.Duration(5)*time.Second,
+	)
+
+	ctrlResult, err := depl.CreateOrPatch(ctx, helper)
+	if err != nil {
+		instance.Status.Conditions.Set(condition.FalseCondition(
+			condition.DeploymentReadyCondition,
+			condition.ErrorReason,
+			condition.SeverityWarning,
+			condition.DeploymentReadyErrorMessage,
+			err.Error()))
+		return ctrlResult, err
+	} else if (ctrlResult != ctrl.Result{}) {
+		instance.Status.Conditions.Set(condition.FalseCondition(
+			condition.DeploymentReadyCondition,
+			condition.RequestedReason,
+			condition.SeverityInfo,
+			condition.DeploymentReadyRunningMessage))
+		return ctrlResult, nil
+	}
+	instance.Status.ReadyCount = depl.GetDeployment().Status.ReadyReplicas
+	if instance.Status.ReadyCount == instance.Spec.Replicas {
+		instance.Status.Conditions.MarkTrue(condition.DeploymentReadyCondition, condition.DeploymentReadyMessage)
+	} else {
+		instance.Status.Conditions.Set(condition.FalseCondition(
+			condition.DeploymentReadyCondition,
+			condition.RequestedReason,
+			condition.SeverityInfo,
+			condition.DeploymentReadyRunningMessage))
+	}
+	// create Deployment - end
+
+	// all steps of the current spec got reconciled
+	instance.Status.ObservedGeneration = instance.Generation
+
+	r.Log.Info("Reconciled Service successfully")
+	return ctrl.Result{}, nil
+}
+
+// generateServiceConfig - create the secrets which hold the service configuration
+//   - %-config-data secret holding the kolla config and the DefaultConfigOverwrite files
+//   - %-config-merged secret holding the designate.conf with the notification handlers, the messaging
+//     config shared with the other designate services and the CustomServiceConfig merged
+func (r *DesignateSinkReconciler) generateServiceConfig(
+	ctx context.Context,
+	instance *designatev1.DesignateSink,
+	h *helper.Helper,
+	transportURL string,
+	envVars *map[string]env.Setter,
+) error {
+	cmLabels := labels.GetLabels(instance, labels.GetGroupLabel(designate.ServiceName), map[string]string{})
+
+	customData := map[string]string{}
+	for key, data := range instance.Spec.DefaultConfigOverwrite {
+		customData[key] = data
+	}
+
+	templateParameters := designatesink.TemplateParameters(instance)
+
+	serviceConfig, err := designate.RenderServiceConfig(
+		designatesink.ServiceConfigTemplate,
+		designatesink.SecretTemplateParameters(templateParameters, transportURL),
+		instance.Spec.CustomServiceConfig,
+	)
+	if err != nil {
+		return err
+	}
+
+	secrets := []util.Template{
+		// ConfigSecret
+		{
+			Name:          fmt.Sprintf("%s-config-data", designatesink.ResourceName(instance)),
+			Namespace:     instance.Namespace,
+			Type:          util.TemplateTypeConfig,
+			InstanceType:  instance.Kind,
+			CustomData:    customData,
+			ConfigOptions: templateParameters,
+			Labels:        cmLabels,
+		},
+		// MergedConfigSecret
+		{
+			Name:         fmt.Sprintf("%s-config-merged", designatesink.ResourceName(instance)),
+			Namespace:    instance.Namespace,
+			Type:         util.TemplateTypeNone,
+			InstanceType: instance.Kind,
+			CustomData:   map[string]string{designate.ServiceConfigFileName: serviceConfig},
+			Labels:       cmLabels,
+		},
+	}
+	return oko_secret.EnsureSecrets(ctx, h, instance, secrets, envVars)
+}
+
+// createHashOfInputHashes - creates a hash of hashes which gets added to the deployment to restart
+// the pods if any of the input resources change
+//
+// returns the hash, whether the hash changed (as a bool) and any error
+func (r *DesignateSinkReconciler) createHashOfInputHashes(
+	instance *designatev1.DesignateSink,
+	envVars map[string]env.Setter,
+) (string, bool, error) {
+	var hashMap map[string]string
+	changed := false
+	mergedMapVars := env.MergeEnvs([]corev1.EnvVar{}, envVars)
+	hash, err := util.ObjectHash(mergedMapVars)
+	if err != nil {
+		return hash, changed, err
+	}
+	if hashMap, changed = util.SetHash(instance.Status.Hash, common.InputHashName, hash); changed {
+		instance.Status.Hash = hashMap
+		r.Log.Info(fmt.Sprintf("Input maps hash %s - %s", common.InputHashName, hash))
+	}
+	return hash, changed, nil
+}
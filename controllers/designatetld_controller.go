@@ -0,0 +1,103 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+
+	"github.com/go-logr/logr"
+	designatev1 "github.com/openstack-k8s-operators/designate-operator/api/v1beta1"
+	"github.com/openstack-k8s-operators/designate-operator/pkg/designatetld"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DesignateTLDReconciler reconciles a DesignateTLD object
+type DesignateTLDReconciler struct {
+	client.Client
+	Kclient kubernetes.Interface
+	Log     logr.Logger
+	Scheme  *runtime.Scheme
+	// NewDesignateClient - creates the designate API clients, defaults to designateclient.NewClient
+	NewDesignateClient DesignateClientFunc
+}
+
+// +kubebuilder:rbac:groups=designate.openstack.org,resources=designatetlds,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=designate.openstack.org,resources=designatetlds/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=designate.openstack.org,resources=designatetlds/finalizers,verbs=update
+// +kubebuilder:rbac:groups=designate.openstack.org,resources=designateapis,verbs=get;list;watch;
+// +kubebuilder:rbac:groups=keystone.openstack.org,resources=keystoneapis,verbs=get;list;watch;
+// +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;
+
+// tldEntryKind - the TLD of a DesignateTLD is an entry of the designate tlds
+var tldEntryKind = designateEntryKind[*designatev1.DesignateTLD]{
+	kind:              "DesignateTLD",
+	name:              "TLD",
+	readyCondition:    designatev1.DesignateTLDReadyCondition,
+	readyInitMessage:  designatev1.DesignateTLDReadyInitMessage,
+	readyMessage:      designatev1.DesignateTLDReadyMessage,
+	readyErrorMessage: designatev1.DesignateTLDReadyErrorMessage,
+	conflictMessage:   designatev1.DesignateTLDConflictMessage,
+	newInstance: func() *designatev1.DesignateTLD {
+		return &designatev1.DesignateTLD{}
+	},
+	newList: func() client.ObjectList {
+		return &designatev1.DesignateTLDList{}
+	},
+	designateAPI: func(instance *designatev1.DesignateTLD) string {
+		return instance.Spec.DesignateAPI
+	},
+	key: func(instance *designatev1.DesignateTLD) string {
+		return instance.Spec.Name
+	},
+	equalKeys: designatetld.EqualNames,
+	status: func(instance *designatev1.DesignateTLD) designateEntryStatus {
+		return designateEntryStatus{
+			Conditions:         &instance.Status.Conditions,
+			ObservedGeneration: &instance.Status.ObservedGeneration,
+			ID:                 &instance.Status.TLDID,
+			Adopted:            &instance.Status.Adopted,
+		}
+	},
+	ensure: designatetld.EnsureTLD,
+	delete: designatetld.DeleteTLD,
+}
+
+// Reconcile - creates the TLD of the DesignateTLD with the designate API and
+// corrects it if it drifted
+func (r *DesignateTLDReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	return r.entryReconciler().Reconcile(ctx, req)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *DesignateTLDReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return r.entryReconciler().SetupWithManager(mgr)
+}
+
+// entryReconciler - returns the reconciler of the TLDs with the clients of the reconciler
+func (r *DesignateTLDReconciler) entryReconciler() *designateEntryReconciler[*designatev1.DesignateTLD] {
+	return &designateEntryReconciler[*designatev1.DesignateTLD]{
+		Client:             r.Client,
+		Kclient:            r.Kclient,
+		Log:                r.Log,
+		Scheme:             r.Scheme,
+		NewDesignateClient: r.NewDesignateClient,
+		kind:               tldEntryKind,
+	}
+}
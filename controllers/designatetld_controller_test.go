@@ -0,0 +1,131 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	designatev1 "github.com/openstack-k8s-operators/designate-operator/api/v1beta1"
+	"github.com/openstack-k8s-operators/designate-operator/pkg/designateclient/fake"
+	"github.com/openstack-k8s-operators/lib-common/modules/common/condition"
+
+	corev1 "k8s.io/api/core/v1"
+	k8s_errors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ = Describe("DesignateTLD controller", func() {
+	var namespace string
+	var name types.NamespacedName
+	var spec designatev1.DesignateTLDSpec
+
+	BeforeEach(func() {
+		namespace = CreateNamespace()
+		name = types.NamespacedName{Namespace: namespace, Name: "tld"}
+		spec = designatev1.DesignateTLDSpec{
+			DesignateAPI: "designate",
+			// the fake server is shared by all tests and the TLDs are unique
+			Name: namespace,
+		}
+	})
+
+	When("the DesignateAPI does not exist", func() {
+		BeforeEach(func() {
+			CreateObject(name, &designatev1.DesignateTLD{Spec: spec})
+		})
+
+		It("waits for the DesignateAPI", func() {
+			Eventually(func(g Gomega) {
+				c := GetObject[designatev1.DesignateTLD](name).Status.Conditions.Get(condition.InputReadyCondition)
+				g.Expect(c).NotTo(BeNil())
+				g.Expect(c.Status).To(Equal(corev1.ConditionFalse))
+				g.Expect(c.Reason).To(Equal(condition.RequestedReason))
+			}, timeout, interval).Should(Succeed())
+		})
+	})
+
+	When("the DesignateAPI is ready", func() {
+		BeforeEach(func() {
+			CreateReadyDesignateAPI(types.NamespacedName{Namespace: namespace, Name: "designate"})
+		})
+
+		It("creates, updates and deletes the TLD", func() {
+			CreateObject(name, &designatev1.DesignateTLD{Spec: spec})
+
+			var tldID string
+			Eventually(func(g Gomega) {
+				instance := GetObject[designatev1.DesignateTLD](name)
+				g.Expect(instance.Status.Conditions.IsTrue(condition.ReadyCondition)).To(BeTrue())
+				g.Expect(instance.Status.Adopted).To(BeFalse())
+				tldID = instance.Status.TLDID
+			}, timeout, interval).Should(Succeed())
+			Expect(designateServer.GetTLD(tldID).Name).To(Equal(namespace))
+
+			Eventually(func(g Gomega) {
+				instance := GetObject[designatev1.DesignateTLD](name)
+				instance.Spec.Description = "test TLD"
+				g.Expect(k8sClient.Update(ctx, instance)).To(Succeed())
+			}, timeout, interval).Should(Succeed())
+			Eventually(func(g Gomega) {
+				g.Expect(designateServer.GetTLD(tldID).Description).To(Equal("test TLD"))
+			}, timeout, interval).Should(Succeed())
+
+			Expect(k8sClient.Delete(ctx, GetObject[designatev1.DesignateTLD](name))).To(Succeed())
+			Eventually(func() bool {
+				return k8s_errors.IsNotFound(k8sClient.Get(ctx, name, &designatev1.DesignateTLD{}))
+			}, timeout, interval).Should(BeTrue())
+			Expect(designateServer.GetTLD(tldID)).To(BeNil())
+		})
+
+		It("adopts an existing TLD and keeps it on delete", func() {
+			tldID := designateServer.AddTLD(fake.TLD{Name: namespace})
+			CreateObject(name, &designatev1.DesignateTLD{Spec: spec})
+
+			Eventually(func(g Gomega) {
+				instance := GetObject[designatev1.DesignateTLD](name)
+				g.Expect(instance.Status.Conditions.IsTrue(condition.ReadyCondition)).To(BeTrue())
+				g.Expect(instance.Status.TLDID).To(Equal(tldID))
+				g.Expect(instance.Status.Adopted).To(BeTrue())
+			}, timeout, interval).Should(Succeed())
+
+			Expect(k8sClient.Delete(ctx, GetObject[designatev1.DesignateTLD](name))).To(Succeed())
+			Eventually(func() bool {
+				return k8s_errors.IsNotFound(k8sClient.Get(ctx, name, &designatev1.DesignateTLD{}))
+			}, timeout, interval).Should(BeTrue())
+			Expect(designateServer.GetTLD(tldID)).NotTo(BeNil())
+		})
+
+		It("is not ready anymore when the DesignateAPI goes down", func() {
+			CreateObject(name, &designatev1.DesignateTLD{Spec: spec})
+			Eventually(func(g Gomega) {
+				g.Expect(GetObject[designatev1.DesignateTLD](name).Status.Conditions.IsTrue(condition.ReadyCondition)).To(BeTrue())
+			}, timeout, interval).Should(Succeed())
+
+			Eventually(func(g Gomega) {
+				deployment := GetDeployment(types.NamespacedName{Namespace: namespace, Name: "designate"})
+				deployment.Status.ReadyReplicas = 0
+				g.Expect(k8sClient.Status().Update(ctx, deployment)).To(Succeed())
+			}, timeout, interval).Should(Succeed())
+
+			Eventually(func(g Gomega) {
+				instance := GetObject[designatev1.DesignateTLD](name)
+				g.Expect(instance.Status.Conditions.IsFalse(condition.InputReadyCondition)).To(BeTrue())
+				g.Expect(instance.Status.Conditions.IsFalse(condition.ReadyCondition)).To(BeTrue())
+			}, timeout, interval).Should(Succeed())
+		})
+	})
+})
@@ -0,0 +1,459 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"github.com/gophercloud/gophercloud"
+	designatev1 "github.com/openstack-k8s-operators/designate-operator/api/v1beta1"
+	"github.com/openstack-k8s-operators/designate-operator/pkg/designate"
+	"github.com/openstack-k8s-operators/designate-operator/pkg/designatetsigkey"
+	"github.com/openstack-k8s-operators/lib-common/modules/common/condition"
+	"github.com/openstack-k8s-operators/lib-common/modules/common/env"
+	"github.com/openstack-k8s-operators/lib-common/modules/common/helper"
+	"github.com/openstack-k8s-operators/lib-common/modules/common/labels"
+	oko_secret "github.com/openstack-k8s-operators/lib-common/modules/common/secret"
+	"github.com/openstack-k8s-operators/lib-common/modules/common/util"
+
+	corev1 "k8s.io/api/core/v1"
+	k8s_errors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+// DesignateTsigKeyReconciler reconciles a DesignateTsigKey object
+type DesignateTsigKeyReconciler struct {
+	client.Client
+	Kclient kubernetes.Interface
+	Log     logr.Logger
+	Scheme  *runtime.Scheme
+	// NewDesignateClient - creates the designate API clients, defaults to designateclient.NewClient
+	NewDesignateClient DesignateClientFunc
+}
+
+// +kubebuilder:rbac:groups=designate.openstack.org,resources=designatetsigkeys,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=designate.openstack.org,resources=designatetsigkeys/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=designate.openstack.org,resources=designatetsigkeys/finalizers,verbs=update
+// +kubebuilder:rbac:groups=designate.openstack.org,resources=designatezones,verbs=get;list;watch;
+// +kubebuilder:rbac:groups=designate.openstack.org,resources=designateapis,verbs=get;list;watch;
+// +kubebuilder:rbac:groups=keystone.openstack.org,resources=keystoneapis,verbs=get;list;watch;
+// +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;create;update;patch;delete;
+
+// Reconcile - generates the secret of the DesignateTsigKey into a Secret and registers the key with
+// designate
+func (r *DesignateTsigKeyReconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, _err error) {
+	_ = r.Log.WithValues("designatetsigkey", req.NamespacedName)
+
+	// Fetch the DesignateTsigKey instance
+	instance := &designatev1.DesignateTsigKey{}
+	err := r.Client.Get(ctx, req.NamespacedName, instance)
+	if err != nil {
+		if k8s_errors.IsNotFound(err) {
+			// Request object not found, could have been deleted after reconcile request.
+			return ctrl.Result{}, nil
+		}
+		// Error reading the object - requeue the request.
+		return ctrl.Result{}, err
+	}
+
+	helper, err := helper.NewHelper(
+		instance,
+		r.Client,
+		r.Kclient,
+		r.Scheme,
+		r.Log,
+	)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Always patch the instance status when exiting this function so we can persist any changes.
+	defer func() {
+		// update the overall status condition if the key is registered and the current spec got reconciled
+		if instance.IsReady() && instance.Status.ObservedGeneration == instance.Generation {
+			instance.Status.Conditions.MarkTrue(condition.ReadyCondition, condition.ReadyMessage)
+		} else if !instance.IsReady() && instance.Status.Conditions.IsTrue(condition.ReadyCondition) {
+			// the key became unready after it got registered, e.g. because the DesignateAPI went down
+			instance.Status.Conditions.MarkFalse(
+				condition.ReadyCondition,
+				condition.RequestedReason,
+				condition.SeverityWarning,
+				designatev1.DesignateNotReadyMessage)
+		}
+
+		err := helper.PatchInstance(ctx, instance)
+		if err != nil {
+			_err = err
+			return
+		}
+	}()
+
+	// If we're not deleting this and the object doesn't have our finalizer, add it.
+	if instance.DeletionTimestamp.IsZero() && controllerutil.AddFinalizer(instance, helper.GetFinalizer()) {
+		return ctrl.Result{}, nil
+	}
+
+	//
+	// initialize status
+	//
+	if instance.Status.Conditions == nil {
+		instance.Status.Conditions = condition.Conditions{}
+
+		cl := condition.CreateList(
+			condition.UnknownCondition(condition.InputReadyCondition, condition.InitReason, condition.InputReadyInitMessage),
+			condition.UnknownCondition(designatev1.DesignateTsigKeyReadyCondition, condition.InitReason, designatev1.DesignateTsigKeyReadyInitMessage),
+		)
+
+		instance.Status.Conditions.Init(&cl)
+
+		// Register overall status immediately to have an early feedback e.g. in the cli
+		return ctrl.Result{}, nil
+	}
+	// Ready refers to the observed generation, reset it until the new spec got reconciled
+	if instance.Status.ObservedGeneration != instance.Generation && !instance.Status.Conditions.IsUnknown(condition.ReadyCondition) {
+		instance.Status.Conditions.MarkUnknown(condition.ReadyCondition, condition.InitReason, condition.ReadyInitMessage)
+	}
+
+	if !instance.DeletionTimestamp.IsZero() {
+		return r.reconcileDelete(ctx, instance, helper)
+	}
+
+	return r.reconcileNormal(ctx, instance, helper)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *DesignateTsigKeyReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// index the referenced DesignateAPI and DesignateZone to find the DesignateTsigKeys to reconcile
+	// when they get ready
+	err := mgr.GetFieldIndexer().IndexField(
+		context.Background(),
+		&designatev1.DesignateTsigKey{},
+		designateAPIField,
+		func(rawObj client.Object) []string {
+			instance := rawObj.(*designatev1.DesignateTsigKey)
+			return []string{instance.Spec.DesignateAPI}
+		})
+	if err != nil {
+		return err
+	}
+	err = mgr.GetFieldIndexer().IndexField(
+		context.Background(),
+		&designatev1.DesignateTsigKey{},
+		zoneRefField,
+		func(rawObj client.Object) []string {
+			instance := rawObj.(*designatev1.DesignateTsigKey)
+			if instance.Spec.ZoneRef == "" {
+				return nil
+			}
+			return []string{instance.Spec.ZoneRef}
+		})
+	if err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&designatev1.DesignateTsigKey{}).
+		Owns(&corev1.Secret{}).
+		Watches(
+			&source.Kind{Type: &designatev1.DesignateAPI{}},
+			handler.EnqueueRequestsFromMapFunc(r.findObjectsForField(designateAPIField)),
+		).
+		Watches(
+			&source.Kind{Type: &designatev1.DesignateZone{}},
+			handler.EnqueueRequestsFromMapFunc(r.findObjectsForField(zoneRefField)),
+		).
+		Complete(r)
+}
+
+// findObjectsForField - returns a map func, which returns a reconcile request for every
+// DesignateTsigKey referencing the object with the indexed field
+func (r *DesignateTsigKeyReconciler) findObjectsForField(field string) handler.MapFunc {
+	return func(obj client.Object) []reconcile.Request {
+		instances := &designatev1.DesignateTsigKeyList{}
+		err := r.Client.List(
+			context.Background(),
+			instances,
+			client.InNamespace(obj.GetNamespace()),
+			client.MatchingFields{field: obj.GetName()},
+		)
+		if err != nil {
+			r.Log.Error(err, fmt.Sprintf("Unable to list DesignateTsigKeys referencing %s", obj.GetName()))
+			return []reconcile.Request{}
+		}
+
+		requests := make([]reconcile.Request, len(instances.Items))
+		for i, instance := range instances.Items {
+			requests[i] = reconcile.Request{
+				NamespacedName: types.NamespacedName{
+					Name:      instance.GetName(),
+					Namespace: instance.GetNamespace(),
+				},
+			}
+		}
+		return requests
+	}
+}
+
+func (r *DesignateTsigKeyReconciler) reconcileDelete(ctx context.Context, instance *designatev1.DesignateTsigKey, helper *helper.Helper) (ctrl.Result, error) {
+	util.LogForObject(helper, "Reconciling TSIG key delete", instance)
+
+	// keys which existed before the DesignateTsigKey are left in designate
+	if instance.Status.TsigKeyID != "" && !instance.Status.Adopted {
+		// without the DesignateAPI the key can not be deleted, which is also the case when the
+		// whole namespace gets deleted
+		designateAPI := &designatev1.DesignateAPI{}
+		err := r.Client.Get(ctx, types.NamespacedName{Namespace: instance.Namespace, Name: instance.Spec.DesignateAPI}, designateAPI)
+		if err != nil && !k8s_errors.IsNotFound(err) {
+			return ctrl.Result{}, err
+		}
+		if k8s_errors.IsNotFound(err) || !designateAPI.DeletionTimestamp.IsZero() {
+			util.LogForObject(helper, fmt.Sprintf("DesignateAPI %s is gone, TSIG key %s is not deleted in designate", instance.Spec.DesignateAPI, instance.Spec.Name), instance)
+		} else {
+			osClient, ctrlResult, err := getDesignateClient(ctx, helper, r.NewDesignateClient, instance.Namespace, instance.Spec.DesignateAPI)
+			if err != nil {
+				return ctrlResult, err
+			} else if (ctrlResult != ctrl.Result{}) {
+				return ctrlResult, nil
+			}
+
+			err = designatetsigkey.DeleteTsigKey(osClient, instance.Status.TsigKeyID)
+			if err != nil {
+				return ctrl.Result{}, err
+			}
+			util.LogForObject(helper, fmt.Sprintf("Deleted TSIG key %s", instance.Spec.Name), instance)
+		}
+	}
+
+	// the Secret of the key is owned by the DesignateTsigKey and gets garbage collected
+	controllerutil.RemoveFinalizer(instance, helper.GetFinalizer())
+	util.LogForObject(helper, "Reconciled TSIG key delete successfully", instance)
+
+	return ctrl.Result{}, nil
+}
+
+func (r *DesignateTsigKeyReconciler) reconcileNormal(ctx context.Context, instance *designatev1.DesignateTsigKey, helper *helper.Helper) (ctrl.Result, error) {
+	osClient, ctrlResult, err := getDesignateClient(ctx, helper, r.NewDesignateClient, instance.Namespace, instance.Spec.DesignateAPI)
+	if err != nil {
+		instance.Status.Conditions.Set(condition.FalseCondition(
+			condition.InputReadyCondition,
+			condition.ErrorReason,
+			condition.SeverityWarning,
+			condition.InputReadyErrorMessage,
+			err.Error()))
+		return ctrlResult, err
+	} else if (ctrlResult != ctrl.Result{}) {
+		instance.Status.Conditions.Set(condition.FalseCondition(
+			condition.InputReadyCondition,
+			condition.RequestedReason,
+			condition.SeverityInfo,
+			designatev1.DesignateAPIWaitingMessage,
+			instance.Spec.DesignateAPI))
+		return ctrlResult, nil
+	}
+
+	//
+	// resolve the pool or zone the key gets attached to
+	//
+	resourceID := instance.Spec.PoolID
+	if resourceID == "" {
+		resourceID = designatetsigkey.DefaultPoolID
+	}
+	if instance.Spec.Scope == designatev1.TsigKeyScopeZone {
+		zone := &designatev1.DesignateZone{}
+		err = r.Client.Get(ctx, types.NamespacedName{Namespace: instance.Namespace, Name: instance.Spec.ZoneRef}, zone)
+		if err != nil && !k8s_errors.IsNotFound(err) {
+			instance.Status.Conditions.Set(condition.FalseCondition(
+				condition.InputReadyCondition,
+				condition.ErrorReason,
+				condition.SeverityWarning,
+				condition.InputReadyErrorMessage,
+				err.Error()))
+			return ctrl.Result{}, err
+		}
+		if k8s_errors.IsNotFound(err) || zone.Status.ZoneID == "" {
+			instance.Status.Conditions.Set(condition.FalseCondition(
+				condition.InputReadyCondition,
+				condition.RequestedReason,
+				condition.SeverityInfo,
+				designatev1.DesignateZoneWaitingMessage,
+				instance.Spec.ZoneRef))
+			return ctrl.Result{RequeueAfter: time.Second * 10}, nil
+		}
+		resourceID = zone.Status.ZoneID
+	}
+	instance.Status.Conditions.MarkTrue(condition.InputReadyCondition, condition.InputReadyMessage)
+
+	// a key is managed by a single DesignateTsigKey, designate does not allow two keys with the same name
+	if instance.Status.TsigKeyID == "" {
+		owner, err := r.getTsigKeyOwner(ctx, instance)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if owner != "" {
+			instance.Status.Conditions.Set(condition.FalseCondition(
+				designatev1.DesignateTsigKeyReadyCondition,
+				condition.ErrorReason,
+				condition.SeverityWarning,
+				designatev1.DesignateTsigKeyConflictMessage,
+				instance.Spec.Name,
+				owner))
+			return ctrl.Result{RequeueAfter: zoneResyncInterval}, nil
+		}
+	}
+
+	secret, err := r.ensureSecret(ctx, instance, helper, osClient)
+	if err != nil {
+		instance.Status.Conditions.Set(condition.FalseCondition(
+			designatev1.DesignateTsigKeyReadyCondition,
+			condition.ErrorReason,
+			condition.SeverityWarning,
+			designatev1.DesignateTsigKeyReadyErrorMessage,
+			err.Error()))
+		return ctrl.Result{}, err
+	}
+
+	tsigKey, adopted, err := designatetsigkey.EnsureTsigKey(osClient, instance, secret, resourceID)
+	if err != nil {
+		instance.Status.Conditions.Set(condition.FalseCondition(
+			designatev1.DesignateTsigKeyReadyCondition,
+			condition.ErrorReason,
+			condition.SeverityWarning,
+			designatev1.DesignateTsigKeyReadyErrorMessage,
+			err.Error()))
+		return ctrl.Result{}, err
+	}
+	if instance.Status.TsigKeyID != tsigKey.ID {
+		if adopted {
+			util.LogForObject(helper, fmt.Sprintf("Adopted TSIG key %s with ID %s", tsigKey.Name, tsigKey.ID), instance)
+		} else {
+			util.LogForObject(helper, fmt.Sprintf("TSIG key %s has ID %s", tsigKey.Name, tsigKey.ID), instance)
+		}
+		instance.Status.Adopted = adopted
+	}
+	instance.Status.TsigKeyID = tsigKey.ID
+	instance.Status.ResourceID = tsigKey.ResourceID
+
+	instance.Status.ObservedGeneration = instance.Generation
+	instance.Status.Conditions.MarkTrue(designatev1.DesignateTsigKeyReadyCondition, designatev1.DesignateTsigKeyReadyMessage)
+
+	// read the key back periodically to correct changes done with the designate API directly
+	return ctrl.Result{RequeueAfter: zoneResyncInterval}, nil
+}
+
+// getTsigKeyOwner - returns the name of another DesignateTsigKey of the DesignateAPI which manages the
+// key with the same name, or an empty string if there is none
+func (r *DesignateTsigKeyReconciler) getTsigKeyOwner(ctx context.Context, instance *designatev1.DesignateTsigKey) (string, error) {
+	instances := &designatev1.DesignateTsigKeyList{}
+	err := r.Client.List(
+		ctx,
+		instances,
+		client.InNamespace(instance.Namespace),
+		client.MatchingFields{designateAPIField: instance.Spec.DesignateAPI},
+	)
+	if err != nil {
+		return "", err
+	}
+
+	for _, other := range instances.Items {
+		// designate compares the key names case insensitive
+		if other.UID != instance.UID && strings.EqualFold(other.Spec.Name, instance.Spec.Name) &&
+			other.Status.TsigKeyID != "" && other.DeletionTimestamp.IsZero() {
+			return other.Name, nil
+		}
+	}
+	return "", nil
+}
+
+// ensureSecret - writes the TSIG key into the Secret of the DesignateTsigKey and returns its secret.
+// The secret of the Secret is kept, if the Secret does not exist yet the secret of an existing key in
+// designate gets imported, so adopting a key does not break the transfers of the DNS servers using it.
+// A new secret gets generated if the generation annotation or the algorithm changed, or if there is
+// no secret to keep.
+func (r *DesignateTsigKeyReconciler) ensureSecret(
+	ctx context.Context,
+	instance *designatev1.DesignateTsigKey,
+	h *helper.Helper,
+	osClient *gophercloud.ServiceClient,
+) (string, error) {
+	secretName := designatetsigkey.SecretName(instance)
+	current, _, err := oko_secret.GetSecret(ctx, h, secretName, instance.Namespace)
+	if err != nil && !k8s_errors.IsNotFound(err) {
+		return "", err
+	}
+
+	// the annotation only requests a new secret once the Secret got written
+	rotate := instance.Status.SecretName != "" && instance.Status.SecretGeneration != instance.GetSecretGeneration()
+
+	secret := ""
+	if !rotate && current != nil && string(current.Data[designatetsigkey.SecretKeyAlgorithm]) == instance.Spec.Algorithm {
+		secret = string(current.Data[designatetsigkey.SecretKeySecret])
+	}
+	if !rotate && current == nil {
+		tsigKey, _, err := designatetsigkey.GetTsigKey(osClient, instance)
+		if err != nil {
+			return "", err
+		}
+		if tsigKey != nil && tsigKey.Algorithm == instance.Spec.Algorithm && tsigKey.Secret != "" {
+			secret = tsigKey.Secret
+			util.LogForObject(h, fmt.Sprintf("Imported the secret of TSIG key %s from designate", instance.Spec.Name), instance)
+		}
+	}
+	if secret == "" {
+		secret, err = designatetsigkey.GenerateSecret(instance.Spec.Algorithm)
+		if err != nil {
+			return "", err
+		}
+		util.LogForObject(h, fmt.Sprintf("Generated a new secret for TSIG key %s", instance.Spec.Name), instance)
+	}
+
+	secrets := []util.Template{
+		{
+			Name:         secretName,
+			Namespace:    instance.Namespace,
+			Type:         util.TemplateTypeNone,
+			InstanceType: instance.Kind,
+			CustomData: map[string]string{
+				designatetsigkey.SecretKeyName:       instance.Spec.Name,
+				designatetsigkey.SecretKeyAlgorithm:  instance.Spec.Algorithm,
+				designatetsigkey.SecretKeySecret:     secret,
+				designatetsigkey.SecretKeyBindConfig: designatetsigkey.BindConfig(instance.Spec.Name, instance.Spec.Algorithm, secret),
+			},
+			Labels: labels.GetLabels(instance, labels.GetGroupLabel(designate.ServiceName), map[string]string{}),
+		},
+	}
+	// the hash is not used, the key is no input of a deployment of the operator
+	envVars := map[string]env.Setter{}
+	err = oko_secret.EnsureSecrets(ctx, h, instance, secrets, &envVars)
+	if err != nil {
+		return "", err
+	}
+	instance.Status.SecretName = secretName
+	instance.Status.SecretGeneration = instance.GetSecretGeneration()
+
+	return secret, nil
+}
@@ -0,0 +1,189 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"encoding/base64"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	designatev1 "github.com/openstack-k8s-operators/designate-operator/api/v1beta1"
+	"github.com/openstack-k8s-operators/designate-operator/pkg/designateclient/fake"
+	"github.com/openstack-k8s-operators/designate-operator/pkg/designatetsigkey"
+	"github.com/openstack-k8s-operators/lib-common/modules/common/condition"
+
+	corev1 "k8s.io/api/core/v1"
+	k8s_errors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ = Describe("DesignateTsigKey controller", func() {
+	var namespace string
+	var name types.NamespacedName
+	var secretName types.NamespacedName
+	var spec designatev1.DesignateTsigKeySpec
+
+	BeforeEach(func() {
+		namespace = CreateNamespace()
+		name = types.NamespacedName{Namespace: namespace, Name: "transfer-key"}
+		secretName = types.NamespacedName{Namespace: namespace, Name: "transfer-key-tsigkey"}
+		spec = designatev1.DesignateTsigKeySpec{
+			DesignateAPI: "designate",
+			// the fake server is shared by all tests and the key names are unique
+			Name:      "transfer-key-" + namespace,
+			Algorithm: "hmac-sha256",
+			Scope:     designatev1.TsigKeyScopePool,
+		}
+	})
+
+	When("the DesignateAPI does not exist", func() {
+		BeforeEach(func() {
+			CreateObject(name, &designatev1.DesignateTsigKey{Spec: spec})
+		})
+
+		It("waits for the DesignateAPI", func() {
+			Eventually(func(g Gomega) {
+				c := GetObject[designatev1.DesignateTsigKey](name).Status.Conditions.Get(condition.InputReadyCondition)
+				g.Expect(c).NotTo(BeNil())
+				g.Expect(c.Status).To(Equal(corev1.ConditionFalse))
+				g.Expect(c.Reason).To(Equal(condition.RequestedReason))
+			}, timeout, interval).Should(Succeed())
+		})
+	})
+
+	When("the DesignateAPI is ready", func() {
+		BeforeEach(func() {
+			CreateReadyDesignateAPI(types.NamespacedName{Namespace: namespace, Name: "designate"})
+			CreateObject(name, &designatev1.DesignateTsigKey{Spec: spec})
+		})
+
+		It("registers, rotates and deletes the key", func() {
+			var tsigKeyID string
+			secret := &corev1.Secret{}
+			Eventually(func(g Gomega) {
+				instance := GetObject[designatev1.DesignateTsigKey](name)
+				g.Expect(instance.Status.Conditions.IsTrue(condition.ReadyCondition)).To(BeTrue())
+				g.Expect(instance.Status.SecretName).To(Equal(secretName.Name))
+				g.Expect(instance.Status.ResourceID).To(Equal(designatetsigkey.DefaultPoolID))
+				tsigKeyID = instance.Status.TsigKeyID
+			}, timeout, interval).Should(Succeed())
+			Expect(k8sClient.Get(ctx, secretName, secret)).To(Succeed())
+			Expect(string(secret.Data["name"])).To(Equal(spec.Name))
+			Expect(string(secret.Data["algorithm"])).To(Equal("hmac-sha256"))
+			firstSecret := string(secret.Data["secret"])
+			Expect(designateServer.GetTsigKey(tsigKeyID).Secret).To(Equal(firstSecret))
+
+			// changing the generation annotation generates a new secret
+			Eventually(func(g Gomega) {
+				instance := GetObject[designatev1.DesignateTsigKey](name)
+				instance.Annotations = map[string]string{designatev1.TsigKeyGenerationAnnotation: "2"}
+				g.Expect(k8sClient.Update(ctx, instance)).To(Succeed())
+			}, timeout, interval).Should(Succeed())
+			Eventually(func(g Gomega) {
+				g.Expect(GetObject[designatev1.DesignateTsigKey](name).Status.SecretGeneration).To(Equal("2"))
+				g.Expect(k8sClient.Get(ctx, secretName, secret)).To(Succeed())
+				g.Expect(string(secret.Data["secret"])).NotTo(Equal(firstSecret))
+				g.Expect(designateServer.GetTsigKey(tsigKeyID).Secret).To(Equal(string(secret.Data["secret"])))
+			}, timeout, interval).Should(Succeed())
+
+			Expect(k8sClient.Delete(ctx, GetObject[designatev1.DesignateTsigKey](name))).To(Succeed())
+			Eventually(func() bool {
+				return k8s_errors.IsNotFound(k8sClient.Get(ctx, name, &designatev1.DesignateTsigKey{}))
+			}, timeout, interval).Should(BeTrue())
+			Expect(designateServer.GetTsigKey(tsigKeyID)).To(BeNil())
+		})
+
+		It("generates a new secret when the algorithm changes", func() {
+			var tsigKeyID string
+			Eventually(func(g Gomega) {
+				instance := GetObject[designatev1.DesignateTsigKey](name)
+				g.Expect(instance.Status.Conditions.IsTrue(condition.ReadyCondition)).To(BeTrue())
+				tsigKeyID = instance.Status.TsigKeyID
+			}, timeout, interval).Should(Succeed())
+
+			Eventually(func(g Gomega) {
+				instance := GetObject[designatev1.DesignateTsigKey](name)
+				instance.Spec.Algorithm = "hmac-sha512"
+				g.Expect(k8sClient.Update(ctx, instance)).To(Succeed())
+			}, timeout, interval).Should(Succeed())
+			Eventually(func(g Gomega) {
+				secret := &corev1.Secret{}
+				g.Expect(k8sClient.Get(ctx, secretName, secret)).To(Succeed())
+				g.Expect(string(secret.Data["algorithm"])).To(Equal("hmac-sha512"))
+				key, err := base64.StdEncoding.DecodeString(string(secret.Data["secret"]))
+				g.Expect(err).NotTo(HaveOccurred())
+				g.Expect(key).To(HaveLen(64))
+				g.Expect(designateServer.GetTsigKey(tsigKeyID).Algorithm).To(Equal("hmac-sha512"))
+				g.Expect(designateServer.GetTsigKey(tsigKeyID).Secret).To(Equal(string(secret.Data["secret"])))
+			}, timeout, interval).Should(Succeed())
+		})
+
+		It("does not manage the key of another DesignateTsigKey", func() {
+			Eventually(func(g Gomega) {
+				g.Expect(GetObject[designatev1.DesignateTsigKey](name).Status.TsigKeyID).NotTo(BeEmpty())
+			}, timeout, interval).Should(Succeed())
+
+			otherName := types.NamespacedName{Namespace: namespace, Name: "transfer-key-other"}
+			CreateObject(otherName, &designatev1.DesignateTsigKey{Spec: spec})
+			Eventually(func(g Gomega) {
+				instance := GetObject[designatev1.DesignateTsigKey](otherName)
+				c := instance.Status.Conditions.Get(designatev1.DesignateTsigKeyReadyCondition)
+				g.Expect(c).NotTo(BeNil())
+				g.Expect(c.Status).To(Equal(corev1.ConditionFalse))
+				g.Expect(c.Reason).To(Equal(condition.ErrorReason))
+				g.Expect(instance.Status.TsigKeyID).To(BeEmpty())
+			}, timeout, interval).Should(Succeed())
+		})
+	})
+
+	When("the key exists in designate", func() {
+		var tsigKeyID string
+
+		BeforeEach(func() {
+			CreateReadyDesignateAPI(types.NamespacedName{Namespace: namespace, Name: "designate"})
+			tsigKeyID = designateServer.AddTsigKey(fake.TsigKey{
+				Name:       spec.Name,
+				Algorithm:  spec.Algorithm,
+				Secret:     "c2VjcmV0",
+				Scope:      string(spec.Scope),
+				ResourceID: designatetsigkey.DefaultPoolID,
+			})
+			CreateObject(name, &designatev1.DesignateTsigKey{Spec: spec})
+		})
+
+		It("adopts the key with its secret and keeps it on delete", func() {
+			Eventually(func(g Gomega) {
+				instance := GetObject[designatev1.DesignateTsigKey](name)
+				g.Expect(instance.Status.Conditions.IsTrue(condition.ReadyCondition)).To(BeTrue())
+				g.Expect(instance.Status.TsigKeyID).To(Equal(tsigKeyID))
+				g.Expect(instance.Status.Adopted).To(BeTrue())
+			}, timeout, interval).Should(Succeed())
+
+			// the DNS servers using the key keep working with the imported secret
+			secret := &corev1.Secret{}
+			Expect(k8sClient.Get(ctx, secretName, secret)).To(Succeed())
+			Expect(string(secret.Data["secret"])).To(Equal("c2VjcmV0"))
+			Expect(designateServer.GetTsigKey(tsigKeyID).Secret).To(Equal("c2VjcmV0"))
+
+			Expect(k8sClient.Delete(ctx, GetObject[designatev1.DesignateTsigKey](name))).To(Succeed())
+			Eventually(func() bool {
+				return k8s_errors.IsNotFound(k8sClient.Get(ctx, name, &designatev1.DesignateTsigKey{}))
+			}, timeout, interval).Should(BeTrue())
+			Expect(designateServer.GetTsigKey(tsigKeyID)).NotTo(BeNil())
+		})
+	})
+})
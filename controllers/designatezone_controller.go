@@ -0,0 +1,341 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	designatev1 "github.com/openstack-k8s-operators/designate-operator/api/v1beta1"
+	"github.com/openstack-k8s-operators/designate-operator/pkg/designateclient"
+	"github.com/openstack-k8s-operators/designate-operator/pkg/designatezone"
+	"github.com/openstack-k8s-operators/lib-common/modules/common/condition"
+	"github.com/openstack-k8s-operators/lib-common/modules/common/helper"
+	"github.com/openstack-k8s-operators/lib-common/modules/common/util"
+
+	k8s_errors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+// zoneResyncInterval - interval the zones get read back from designate, to pick up e.g. the
+// serial changes of record updates or zones which got modified with the designate API directly
+const zoneResyncInterval = time.Minute
+
+// DesignateZoneReconciler reconciles a DesignateZone object
+type DesignateZoneReconciler struct {
+	client.Client
+	Kclient kubernetes.Interface
+	Log     logr.Logger
+	Scheme  *runtime.Scheme
+	// NewDesignateClient - creates the designate API clients, defaults to designateclient.NewClient
+	NewDesignateClient DesignateClientFunc
+}
+
+// +kubebuilder:rbac:groups=designate.openstack.org,resources=designatezones,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=designate.openstack.org,resources=designatezones/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=designate.openstack.org,resources=designatezones/finalizers,verbs=update
+// +kubebuilder:rbac:groups=designate.openstack.org,resources=designateapis,verbs=get;list;watch;
+// +kubebuilder:rbac:groups=keystone.openstack.org,resources=keystoneapis,verbs=get;list;watch;
+// +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;
+
+// Reconcile - creates the zone of the DesignateZone with the designate API and syncs its state back
+func (r *DesignateZoneReconciler) Reconcile(ctx context.Context, req ctrl.Request) (result ctrl.Result, _err error) {
+	_ = r.Log.WithValues("designatezone", req.NamespacedName)
+
+	// Fetch the DesignateZone instance
+	instance := &designatev1.DesignateZone{}
+	err := r.Client.Get(ctx, req.NamespacedName, instance)
+	if err != nil {
+		if k8s_errors.IsNotFound(err) {
+			// Request object not found, could have been deleted after reconcile request.
+			return ctrl.Result{}, nil
+		}
+		// Error reading the object - requeue the request.
+		return ctrl.Result{}, err
+	}
+
+	helper, err := helper.NewHelper(
+		instance,
+		r.Client,
+		r.Kclient,
+		r.Scheme,
+		r.Log,
+	)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// Always patch the instance status when exiting this function so we can persist any changes.
+	defer func() {
+		// update the overall status condition if the zone is ready and the current spec got reconciled
+		if instance.IsReady() && instance.Status.ObservedGeneration == instance.Generation {
+			instance.Status.Conditions.MarkTrue(condition.ReadyCondition, condition.ReadyMessage)
+		} else if !instance.IsReady() && instance.Status.Conditions.IsTrue(condition.ReadyCondition) {
+			// the zone became unready after it was ready, e.g. because designate failed to update it
+			instance.Status.Conditions.MarkFalse(
+				condition.ReadyCondition,
+				condition.RequestedReason,
+				condition.SeverityWarning,
+				designatev1.DesignateNotReadyMessage)
+		}
+
+		err := helper.PatchInstance(ctx, instance)
+		if err != nil {
+			_err = err
+			return
+		}
+	}()
+
+	// If we're not deleting this and the object doesn't have our finalizer, add it.
+	if instance.DeletionTimestamp.IsZero() && controllerutil.AddFinalizer(instance, helper.GetFinalizer()) {
+		return ctrl.Result{}, nil
+	}
+
+	//
+	// initialize status
+	//
+	if instance.Status.Conditions == nil {
+		instance.Status.Conditions = condition.Conditions{}
+
+		cl := condition.CreateList(
+			condition.UnknownCondition(condition.InputReadyCondition, condition.InitReason, condition.InputReadyInitMessage),
+			condition.UnknownCondition(designatev1.DesignateZoneReadyCondition, condition.InitReason, designatev1.DesignateZoneReadyInitMessage),
+		)
+
+		instance.Status.Conditions.Init(&cl)
+
+		// Register overall status immediately to have an early feedback e.g. in the cli
+		return ctrl.Result{}, nil
+	}
+	// Ready refers to the observed generation, reset it until the new spec got reconciled
+	if instance.Status.ObservedGeneration != instance.Generation && !instance.Status.Conditions.IsUnknown(condition.ReadyCondition) {
+		instance.Status.Conditions.MarkUnknown(condition.ReadyCondition, condition.InitReason, condition.ReadyInitMessage)
+	}
+
+	if !instance.DeletionTimestamp.IsZero() {
+		return r.reconcileDelete(ctx, instance, helper)
+	}
+
+	return r.reconcileNormal(ctx, instance, helper)
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *DesignateZoneReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// index the referenced DesignateAPI to find the DesignateZones to reconcile on DesignateAPI changes
+	err := mgr.GetFieldIndexer().IndexField(
+		context.Background(),
+		&designatev1.DesignateZone{},
+		designateAPIField,
+		func(rawObj client.Object) []string {
+			instance := rawObj.(*designatev1.DesignateZone)
+			return []string{instance.Spec.DesignateAPI}
+		})
+	if err != nil {
+		return err
+	}
+
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&designatev1.DesignateZone{}).
+		Watches(
+			&source.Kind{Type: &designatev1.DesignateAPI{}},
+			handler.EnqueueRequestsFromMapFunc(r.findObjectsForDesignateAPI),
+		).
+		Complete(r)
+}
+
+// findObjectsForDesignateAPI - returns a reconcile request for every DesignateZone managed with the
+// DesignateAPI, e.g. to create the zones as soon as the DesignateAPI is ready
+func (r *DesignateZoneReconciler) findObjectsForDesignateAPI(designateAPI client.Object) []reconcile.Request {
+	instances := &designatev1.DesignateZoneList{}
+	err := r.Client.List(
+		context.Background(),
+		instances,
+		client.InNamespace(designateAPI.GetNamespace()),
+		client.MatchingFields{designateAPIField: designateAPI.GetName()},
+	)
+	if err != nil {
+		r.Log.Error(err, fmt.Sprintf("Unable to list DesignateZones of DesignateAPI %s", designateAPI.GetName()))
+		return []reconcile.Request{}
+	}
+
+	requests := make([]reconcile.Request, len(instances.Items))
+	for i, instance := range instances.Items {
+		requests[i] = reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Name:      instance.GetName(),
+				Namespace: instance.GetNamespace(),
+			},
+		}
+	}
+	return requests
+}
+
+func (r *DesignateZoneReconciler) reconcileDelete(ctx context.Context, instance *designatev1.DesignateZone, helper *helper.Helper) (ctrl.Result, error) {
+	util.LogForObject(helper, "Reconciling zone delete", instance)
+
+	// zones which existed before the DesignateZone are left in designate
+	if instance.Status.ZoneID != "" && !instance.Status.Adopted {
+		// without the DesignateAPI the zone can not be deleted, which is also the case when the
+		// whole namespace gets deleted
+		designateAPI := &designatev1.DesignateAPI{}
+		err := r.Client.Get(ctx, types.NamespacedName{Namespace: instance.Namespace, Name: instance.Spec.DesignateAPI}, designateAPI)
+		if err != nil && !k8s_errors.IsNotFound(err) {
+			return ctrl.Result{}, err
+		}
+		if k8s_errors.IsNotFound(err) || !designateAPI.DeletionTimestamp.IsZero() {
+			util.LogForObject(helper, fmt.Sprintf("DesignateAPI %s is gone, zone %s is not deleted in designate", instance.Spec.DesignateAPI, instance.Spec.Name), instance)
+		} else {
+			osClient, ctrlResult, err := getDesignateClient(ctx, helper, r.NewDesignateClient, instance.Namespace, instance.Spec.DesignateAPI)
+			if err != nil {
+				return ctrlResult, err
+			} else if (ctrlResult != ctrl.Result{}) {
+				return ctrlResult, nil
+			}
+
+			err = designatezone.DeleteZone(osClient, instance.Status.ZoneID)
+			if err != nil {
+				return ctrl.Result{}, err
+			}
+			util.LogForObject(helper, fmt.Sprintf("Deleted zone %s", instance.Spec.Name), instance)
+		}
+	}
+
+	controllerutil.RemoveFinalizer(instance, helper.GetFinalizer())
+	util.LogForObject(helper, "Reconciled zone delete successfully", instance)
+
+	return ctrl.Result{}, nil
+}
+
+func (r *DesignateZoneReconciler) reconcileNormal(ctx context.Context, instance *designatev1.DesignateZone, helper *helper.Helper) (ctrl.Result, error) {
+	osClient, ctrlResult, err := getDesignateClient(ctx, helper, r.NewDesignateClient, instance.Namespace, instance.Spec.DesignateAPI)
+	if err != nil {
+		instance.Status.Conditions.Set(condition.FalseCondition(
+			condition.InputReadyCondition,
+			condition.ErrorReason,
+			condition.SeverityWarning,
+			condition.InputReadyErrorMessage,
+			err.Error()))
+		return ctrlResult, err
+	} else if (ctrlResult != ctrl.Result{}) {
+		instance.Status.Conditions.Set(condition.FalseCondition(
+			condition.InputReadyCondition,
+			condition.RequestedReason,
+			condition.SeverityInfo,
+			designatev1.DesignateAPIWaitingMessage,
+			instance.Spec.DesignateAPI))
+		return ctrlResult, nil
+	}
+	instance.Status.Conditions.MarkTrue(condition.InputReadyCondition, condition.InputReadyMessage)
+
+	// a zone is managed by a single DesignateZone
+	if instance.Status.ZoneID == "" {
+		owner, err := r.getZoneOwner(ctx, instance)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if owner != "" {
+			instance.Status.Conditions.Set(condition.FalseCondition(
+				designatev1.DesignateZoneReadyCondition,
+				condition.ErrorReason,
+				condition.SeverityWarning,
+				designatev1.DesignateZoneConflictMessage,
+				instance.Spec.Name,
+				owner))
+			return ctrl.Result{RequeueAfter: zoneResyncInterval}, nil
+		}
+	}
+
+	zone, adopted, err := designatezone.EnsureZone(osClient, instance)
+	if err != nil {
+		instance.Status.Conditions.Set(condition.FalseCondition(
+			designatev1.DesignateZoneReadyCondition,
+			condition.ErrorReason,
+			condition.SeverityWarning,
+			designatev1.DesignateZoneReadyErrorMessage,
+			err.Error()))
+		return ctrl.Result{}, err
+	}
+	if instance.Status.ZoneID != zone.ID {
+		if adopted {
+			util.LogForObject(helper, fmt.Sprintf("Adopted zone %s with ID %s", zone.Name, zone.ID), instance)
+		} else {
+			util.LogForObject(helper, fmt.Sprintf("Zone %s has ID %s", zone.Name, zone.ID), instance)
+		}
+		instance.Status.Adopted = adopted
+	}
+	instance.Status.ZoneID = zone.ID
+	instance.Status.Serial = int64(zone.Serial)
+	instance.Status.ZoneStatus = zone.Status
+	instance.Status.Action = zone.Action
+
+	// all steps of the current spec got reconciled, designate processes the changes asynchronously
+	instance.Status.ObservedGeneration = instance.Generation
+
+	switch zone.Status {
+	case designateclient.StatusActive:
+		instance.Status.Conditions.MarkTrue(designatev1.DesignateZoneReadyCondition, designatev1.DesignateZoneReadyMessage)
+	case designateclient.StatusError:
+		instance.Status.Conditions.Set(condition.FalseCondition(
+			designatev1.DesignateZoneReadyCondition,
+			condition.ErrorReason,
+			condition.SeverityError,
+			designatev1.DesignateZoneReadyErrorMessage,
+			fmt.Sprintf("designate failed to %s the zone", zone.Action)))
+	default:
+		instance.Status.Conditions.Set(condition.FalseCondition(
+			designatev1.DesignateZoneReadyCondition,
+			condition.RequestedReason,
+			condition.SeverityInfo,
+			designatev1.DesignateZoneReadyRunningMessage,
+			zone.Action))
+		return ctrl.Result{RequeueAfter: time.Second * 5}, nil
+	}
+
+	return ctrl.Result{RequeueAfter: zoneResyncInterval}, nil
+}
+
+// getZoneOwner - returns the name of another DesignateZone of the DesignateAPI which manages the zone
+// with the same name, or an empty string if there is none
+func (r *DesignateZoneReconciler) getZoneOwner(ctx context.Context, instance *designatev1.DesignateZone) (string, error) {
+	instances := &designatev1.DesignateZoneList{}
+	err := r.Client.List(
+		ctx,
+		instances,
+		client.InNamespace(instance.Namespace),
+		client.MatchingFields{designateAPIField: instance.Spec.DesignateAPI},
+	)
+	if err != nil {
+		return "", err
+	}
+
+	for _, other := range instances.Items {
+		if other.UID != instance.UID && other.Spec.Name == instance.Spec.Name &&
+			other.Status.ZoneID != "" && other.DeletionTimestamp.IsZero() {
+			return other.Name, nil
+		}
+	}
+	return "", nil
+}
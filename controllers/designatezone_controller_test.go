@@ -0,0 +1,158 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	designatev1 "github.com/openstack-k8s-operators/designate-operator/api/v1beta1"
+	"github.com/openstack-k8s-operators/designate-operator/pkg/designateclient"
+	"github.com/openstack-k8s-operators/designate-operator/pkg/designateclient/fake"
+	"github.com/openstack-k8s-operators/lib-common/modules/common/condition"
+
+	corev1 "k8s.io/api/core/v1"
+	k8s_errors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+var _ = Describe("DesignateZone controller", func() {
+	var namespace string
+	var name types.NamespacedName
+	var spec designatev1.DesignateZoneSpec
+
+	BeforeEach(func() {
+		namespace = CreateNamespace()
+		name = types.NamespacedName{Namespace: namespace, Name: "example-com"}
+		spec = designatev1.DesignateZoneSpec{
+			DesignateAPI: "designate",
+			Name:         "example-" + namespace + ".com.",
+			Email:        "hostmaster@example.com",
+			TTL:          3600,
+			Type:         designatev1.ZoneTypePrimary,
+		}
+	})
+
+	When("the DesignateAPI does not exist", func() {
+		BeforeEach(func() {
+			CreateObject(name, &designatev1.DesignateZone{Spec: spec})
+		})
+
+		It("waits for the DesignateAPI", func() {
+			Eventually(func(g Gomega) {
+				c := GetObject[designatev1.DesignateZone](name).Status.Conditions.Get(condition.InputReadyCondition)
+				g.Expect(c).NotTo(BeNil())
+				g.Expect(c.Status).To(Equal(corev1.ConditionFalse))
+				g.Expect(c.Reason).To(Equal(condition.RequestedReason))
+			}, timeout, interval).Should(Succeed())
+		})
+
+		It("gets deleted without the DesignateAPI", func() {
+			Eventually(func() []string {
+				return GetObject[designatev1.DesignateZone](name).Finalizers
+			}, timeout, interval).ShouldNot(BeEmpty())
+
+			Expect(k8sClient.Delete(ctx, GetObject[designatev1.DesignateZone](name))).To(Succeed())
+			Eventually(func() bool {
+				return k8s_errors.IsNotFound(k8sClient.Get(ctx, name, &designatev1.DesignateZone{}))
+			}, timeout, interval).Should(BeTrue())
+		})
+	})
+
+	When("the DesignateAPI is ready", func() {
+		BeforeEach(func() {
+			CreateReadyDesignateAPI(types.NamespacedName{Namespace: namespace, Name: "designate"})
+			CreateObject(name, &designatev1.DesignateZone{Spec: spec})
+		})
+
+		It("creates, updates and deletes the zone in designate", func() {
+			var zoneID string
+			Eventually(func(g Gomega) {
+				instance := GetObject[designatev1.DesignateZone](name)
+				g.Expect(instance.Status.Conditions.IsTrue(condition.ReadyCondition)).To(BeTrue())
+				g.Expect(instance.Status.ZoneStatus).To(Equal("ACTIVE"))
+				g.Expect(instance.Status.Serial).To(Equal(int64(1)))
+				zoneID = instance.Status.ZoneID
+			}, timeout, interval).Should(Succeed())
+			Expect(designateServer.GetZone(zoneID).Name).To(Equal(spec.Name))
+
+			Eventually(func(g Gomega) {
+				instance := GetObject[designatev1.DesignateZone](name)
+				instance.Spec.TTL = 300
+				g.Expect(k8sClient.Update(ctx, instance)).To(Succeed())
+			}, timeout, interval).Should(Succeed())
+			Eventually(func(g Gomega) {
+				g.Expect(designateServer.GetZone(zoneID).TTL).To(Equal(300))
+				g.Expect(GetObject[designatev1.DesignateZone](name).Status.Serial).To(Equal(int64(2)))
+			}, timeout, interval).Should(Succeed())
+
+			Expect(k8sClient.Delete(ctx, GetObject[designatev1.DesignateZone](name))).To(Succeed())
+			Eventually(func() bool {
+				return k8s_errors.IsNotFound(k8sClient.Get(ctx, name, &designatev1.DesignateZone{}))
+			}, timeout, interval).Should(BeTrue())
+			Expect(designateServer.GetZone(zoneID)).To(BeNil())
+		})
+
+		It("does not manage the zone of another DesignateZone", func() {
+			Eventually(func(g Gomega) {
+				g.Expect(GetObject[designatev1.DesignateZone](name).Status.ZoneID).NotTo(BeEmpty())
+			}, timeout, interval).Should(Succeed())
+
+			otherName := types.NamespacedName{Namespace: namespace, Name: "example-com-other"}
+			CreateObject(otherName, &designatev1.DesignateZone{Spec: spec})
+			Eventually(func(g Gomega) {
+				instance := GetObject[designatev1.DesignateZone](otherName)
+				c := instance.Status.Conditions.Get(designatev1.DesignateZoneReadyCondition)
+				g.Expect(c).NotTo(BeNil())
+				g.Expect(c.Status).To(Equal(corev1.ConditionFalse))
+				g.Expect(c.Reason).To(Equal(condition.ErrorReason))
+				g.Expect(instance.Status.ZoneID).To(BeEmpty())
+			}, timeout, interval).Should(Succeed())
+		})
+	})
+
+	When("the zone exists in designate", func() {
+		var zoneID string
+
+		BeforeEach(func() {
+			CreateReadyDesignateAPI(types.NamespacedName{Namespace: namespace, Name: "designate"})
+			zoneID = designateServer.AddZone(fake.Zone{
+				Name:   spec.Name,
+				Email:  spec.Email,
+				TTL:    int(spec.TTL),
+				Type:   "PRIMARY",
+				Status: designateclient.StatusActive,
+				Action: "NONE",
+			})
+			CreateObject(name, &designatev1.DesignateZone{Spec: spec})
+		})
+
+		It("adopts the zone and keeps it on delete", func() {
+			Eventually(func(g Gomega) {
+				instance := GetObject[designatev1.DesignateZone](name)
+				g.Expect(instance.Status.Conditions.IsTrue(condition.ReadyCondition)).To(BeTrue())
+				g.Expect(instance.Status.ZoneID).To(Equal(zoneID))
+				g.Expect(instance.Status.Adopted).To(BeTrue())
+			}, timeout, interval).Should(Succeed())
+
+			Expect(k8sClient.Delete(ctx, GetObject[designatev1.DesignateZone](name))).To(Succeed())
+			Eventually(func() bool {
+				return k8s_errors.IsNotFound(k8sClient.Get(ctx, name, &designatev1.DesignateZone{}))
+			}, timeout, interval).Should(BeTrue())
+			Expect(designateServer.GetZone(zoneID)).NotTo(BeNil())
+		})
+	})
+})
@@ -0,0 +1,153 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"time"
+
+	designatev1 "github.com/openstack-k8s-operators/designate-operator/api/v1beta1"
+	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	metricsNamespace = "designate_operator"
+	metricsSubsystem = "designateapi"
+)
+
+var (
+	// dbSyncDuration - duration of the db-sync jobs, from job start to completion
+	dbSyncDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "dbsync_duration_seconds",
+			Help:      "Duration of the designate db-sync job from start to completion",
+			Buckets:   []float64{5, 10, 30, 60, 120, 300, 600, 1200},
+		},
+		[]string{"namespace", "name"},
+	)
+
+	// dbSyncLastResult - 1 if the last db-sync job succeeded, 0 if it failed
+	dbSyncLastResult = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "dbsync_last_result",
+			Help:      "Result of the last designate db-sync job, 1 for success and 0 for failure",
+		},
+		[]string{"namespace", "name"},
+	)
+
+	// replicasDesired - replicas requested in the spec
+	replicasDesired = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "replicas_desired",
+			Help:      "Number of designate API replicas requested in the spec",
+		},
+		[]string{"namespace", "name"},
+	)
+
+	// replicasReady - ready replicas of the deployment
+	replicasReady = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "replicas_ready",
+			Help:      "Number of ready designate API replicas",
+		},
+		[]string{"namespace", "name"},
+	)
+
+	// conditionStatus - one series per condition and possible status, the current status is 1
+	conditionStatus = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "condition",
+			Help:      "Status of the conditions of the DesignateAPI, 1 for the current status of the condition",
+		},
+		[]string{"namespace", "name", "type", "status"},
+	)
+
+	// keystoneRegistrationFailures - failed attempts to register the service and endpoints in keystone
+	keystoneRegistrationFailures = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Subsystem: metricsSubsystem,
+			Name:      "keystone_registration_failures_total",
+			Help:      "Number of failed attempts to register the designate service and endpoints in keystone",
+		},
+		[]string{"namespace", "name"},
+	)
+)
+
+func init() {
+	// Register the custom metrics with the controller-runtime registry, which gets
+	// exposed by the metrics endpoint of the manager
+	metrics.Registry.MustRegister(
+		dbSyncDuration,
+		dbSyncLastResult,
+		replicasDesired,
+		replicasReady,
+		conditionStatus,
+		keystoneRegistrationFailures,
+	)
+}
+
+// recordDBSyncResult - records the result and, if known, the duration of a finished db-sync job
+func recordDBSyncResult(instance *designatev1.DesignateAPI, succeeded bool, duration time.Duration) {
+	result := 0.0
+	if succeeded {
+		result = 1.0
+	}
+	dbSyncLastResult.WithLabelValues(instance.Namespace, instance.Name).Set(result)
+
+	if duration > 0 {
+		dbSyncDuration.WithLabelValues(instance.Namespace, instance.Name).Observe(duration.Seconds())
+	}
+}
+
+// recordInstanceMetrics - updates the replica and condition gauges from the instance
+func recordInstanceMetrics(instance *designatev1.DesignateAPI) {
+	replicasDesired.WithLabelValues(instance.Namespace, instance.Name).Set(float64(instance.Spec.Replicas))
+	replicasReady.WithLabelValues(instance.Namespace, instance.Name).Set(float64(instance.Status.ReadyCount))
+
+	for _, c := range instance.Status.Conditions {
+		for _, s := range []corev1.ConditionStatus{corev1.ConditionTrue, corev1.ConditionFalse, corev1.ConditionUnknown} {
+			value := 0.0
+			if c.Status == s {
+				value = 1.0
+			}
+			conditionStatus.WithLabelValues(instance.Namespace, instance.Name, string(c.Type), string(s)).Set(value)
+		}
+	}
+}
+
+// deleteInstanceMetrics - removes all series of the instance
+func deleteInstanceMetrics(instance *designatev1.DesignateAPI) {
+	labels := prometheus.Labels{"namespace": instance.Namespace, "name": instance.Name}
+
+	dbSyncDuration.Delete(labels)
+	dbSyncLastResult.Delete(labels)
+	replicasDesired.Delete(labels)
+	replicasReady.Delete(labels)
+	keystoneRegistrationFailures.Delete(labels)
+	conditionStatus.DeletePartialMatch(labels)
+}
@@ -17,29 +17,54 @@ limitations under the License.
 package controllers
 
 import (
+	"context"
+	"os"
+	"os/exec"
 	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 
+	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
+	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/envtest"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 
+	"github.com/gophercloud/gophercloud"
+	routev1 "github.com/openshift/api/route/v1"
 	designatev1beta1 "github.com/openstack-k8s-operators/designate-operator/api/v1beta1"
+	"github.com/openstack-k8s-operators/designate-operator/pkg/designateclient"
+	"github.com/openstack-k8s-operators/designate-operator/pkg/designateclient/fake"
+	keystonev1 "github.com/openstack-k8s-operators/keystone-operator/api/v1beta1"
+	"github.com/openstack-k8s-operators/lib-common/modules/openstack"
+	mariadbv1 "github.com/openstack-k8s-operators/mariadb-operator/api/v1beta1"
 	//+kubebuilder:scaffold:imports
 )
 
 // These tests use Ginkgo (BDD-style Go testing framework). Refer to
 // http://onsi.github.io/ginkgo/ to learn more about Ginkgo.
 
+const (
+	timeout  = time.Second * 20
+	interval = time.Millisecond * 200
+)
+
 var cfg *rest.Config
 var k8sClient client.Client
 var testEnv *envtest.Environment
+var ctx context.Context
+var cancel context.CancelFunc
+
+// designateServer - fake of keystone and the designate API, the controllers talk to it instead of
+// the endpoints of the KeystoneAPI and DesignateAPI, which do not run in the envtest
+var designateServer *fake.Server
 
 func TestAPIs(t *testing.T) {
 	RegisterFailHandler(Fail)
@@ -50,13 +75,30 @@ func TestAPIs(t *testing.T) {
 var _ = BeforeSuite(func() {
 	logf.SetLogger(zap.New(zap.WriteTo(GinkgoWriter), zap.UseDevMode(true)))
 
+	// the envtest binaries get installed and referenced by 'make test'
+	Expect(os.Getenv("KUBEBUILDER_ASSETS")).NotTo(BeEmpty(),
+		"KUBEBUILDER_ASSETS not set, run the controller tests using 'make test'")
+
+	// the config templates get rendered from the lib-common modules, which can not
+	// find the templates of the operator relative to their own location
+	templates, err := filepath.Abs(filepath.Join("..", "templates"))
+	Expect(err).NotTo(HaveOccurred())
+	Expect(os.Setenv("OPERATOR_TEMPLATES", templates+"/")).To(Succeed())
+
+	ctx, cancel = context.WithCancel(context.TODO())
+
 	By("bootstrapping test environment")
 	testEnv = &envtest.Environment{
-		CRDDirectoryPaths:     []string{filepath.Join("..", "config", "crd", "bases")},
+		CRDDirectoryPaths: []string{
+			filepath.Join("..", "config", "crd", "bases"),
+			getDependencyCRDs("github.com/openstack-k8s-operators/keystone-operator/api"),
+			getDependencyCRDs("github.com/openstack-k8s-operators/mariadb-operator/api"),
+			// github.com/openshift/api does not ship the Route CRD
+			filepath.Join("testdata", "crds"),
+		},
 		ErrorIfCRDPathMissing: true,
 	}
 
-	var err error
 	// cfg is defined in this file globally.
 	cfg, err = testEnv.Start()
 	Expect(err).NotTo(HaveOccurred())
@@ -64,6 +106,12 @@ var _ = BeforeSuite(func() {
 
 	err = designatev1beta1.AddToScheme(scheme.Scheme)
 	Expect(err).NotTo(HaveOccurred())
+	err = mariadbv1.AddToScheme(scheme.Scheme)
+	Expect(err).NotTo(HaveOccurred())
+	err = keystonev1.AddToScheme(scheme.Scheme)
+	Expect(err).NotTo(HaveOccurred())
+	err = routev1.AddToScheme(scheme.Scheme)
+	Expect(err).NotTo(HaveOccurred())
 
 	//+kubebuilder:scaffold:scheme
 
@@ -71,10 +119,114 @@ var _ = BeforeSuite(func() {
 	Expect(err).NotTo(HaveOccurred())
 	Expect(k8sClient).NotTo(BeNil())
 
+	kclient, err := kubernetes.NewForConfig(cfg)
+	Expect(err).NotTo(HaveOccurred())
+
+	k8sManager, err := ctrl.NewManager(cfg, ctrl.Options{
+		Scheme:             scheme.Scheme,
+		MetricsBindAddress: "0",
+	})
+	Expect(err).NotTo(HaveOccurred())
+
+	err = (&DesignateAPIReconciler{
+		Client:   k8sManager.GetClient(),
+		Scheme:   k8sManager.GetScheme(),
+		Kclient:  kclient,
+		Log:      ctrl.Log.WithName("controllers").WithName("DesignateAPI"),
+		Recorder: k8sManager.GetEventRecorderFor("designateapi-controller"),
+	}).SetupWithManager(k8sManager)
+	Expect(err).NotTo(HaveOccurred())
+
+	err = (&DesignateSinkReconciler{
+		Client:  k8sManager.GetClient(),
+		Scheme:  k8sManager.GetScheme(),
+		Kclient: kclient,
+		Log:     ctrl.Log.WithName("controllers").WithName("DesignateSink"),
+	}).SetupWithManager(k8sManager)
+	Expect(err).NotTo(HaveOccurred())
+
+	designateServer = fake.NewServer()
+	newDesignateClient := func(authOpts openstack.AuthOpts, _ string) (*gophercloud.ServiceClient, error) {
+		authOpts.AuthURL = designateServer.URL
+		return designateclient.NewClient(authOpts, designateServer.URL)
+	}
+
+	err = (&DesignateZoneReconciler{
+		Client:             k8sManager.GetClient(),
+		Scheme:             k8sManager.GetScheme(),
+		Kclient:            kclient,
+		Log:                ctrl.Log.WithName("controllers").WithName("DesignateZone"),
+		NewDesignateClient: newDesignateClient,
+	}).SetupWithManager(k8sManager)
+	Expect(err).NotTo(HaveOccurred())
+
+	err = (&DesignateRecordSetReconciler{
+		Client:             k8sManager.GetClient(),
+		Scheme:             k8sManager.GetScheme(),
+		Kclient:            kclient,
+		Log:                ctrl.Log.WithName("controllers").WithName("DesignateRecordSet"),
+		NewDesignateClient: newDesignateClient,
+	}).SetupWithManager(k8sManager)
+	Expect(err).NotTo(HaveOccurred())
+
+	err = (&DesignateQuotaReconciler{
+		Client:             k8sManager.GetClient(),
+		Scheme:             k8sManager.GetScheme(),
+		Kclient:            kclient,
+		Log:                ctrl.Log.WithName("controllers").WithName("DesignateQuota"),
+		NewDesignateClient: newDesignateClient,
+	}).SetupWithManager(k8sManager)
+	Expect(err).NotTo(HaveOccurred())
+
+	err = (&DesignateTsigKeyReconciler{
+		Client:             k8sManager.GetClient(),
+		Scheme:             k8sManager.GetScheme(),
+		Kclient:            kclient,
+		Log:                ctrl.Log.WithName("controllers").WithName("DesignateTsigKey"),
+		NewDesignateClient: newDesignateClient,
+	}).SetupWithManager(k8sManager)
+	Expect(err).NotTo(HaveOccurred())
+
+	err = (&DesignateBlacklistReconciler{
+		Client:             k8sManager.GetClient(),
+		Scheme:             k8sManager.GetScheme(),
+		Kclient:            kclient,
+		Log:                ctrl.Log.WithName("controllers").WithName("DesignateBlacklist"),
+		NewDesignateClient: newDesignateClient,
+	}).SetupWithManager(k8sManager)
+	Expect(err).NotTo(HaveOccurred())
+
+	err = (&DesignateTLDReconciler{
+		Client:             k8sManager.GetClient(),
+		Scheme:             k8sManager.GetScheme(),
+		Kclient:            kclient,
+		Log:                ctrl.Log.WithName("controllers").WithName("DesignateTLD"),
+		NewDesignateClient: newDesignateClient,
+	}).SetupWithManager(k8sManager)
+	Expect(err).NotTo(HaveOccurred())
+
+	go func() {
+		defer GinkgoRecover()
+		err = k8sManager.Start(ctx)
+		Expect(err).NotTo(HaveOccurred(), "failed to run manager")
+	}()
 })
 
 var _ = AfterSuite(func() {
+	if testEnv == nil {
+		return
+	}
+	cancel()
+	designateServer.Close()
 	By("tearing down the test environment")
 	err := testEnv.Stop()
 	Expect(err).NotTo(HaveOccurred())
 })
+
+// getDependencyCRDs - returns the directory of the CRD bases shipped with the api module of a dependency
+func getDependencyCRDs(module string) string {
+	out, err := exec.Command("go", "list", "-m", "-f", "{{.Dir}}", module).Output()
+	Expect(err).NotTo(HaveOccurred())
+
+	return filepath.Join(strings.TrimSpace(string(out)), "bases")
+}
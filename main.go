@@ -110,14 +110,90 @@ func main() {
 	}
 
 	if err = (&controllers.DesignateAPIReconciler{
+		Client:   mgr.GetClient(),
+		Scheme:   mgr.GetScheme(),
+		Kclient:  kclient,
+		Log:      ctrl.Log.WithName("controllers").WithName("DesignateAPI"),
+		Recorder: mgr.GetEventRecorderFor("designateapi-controller"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "DesignateAPI")
+		os.Exit(1)
+	}
+	if err = (&controllers.DesignateSinkReconciler{
 		Client:  mgr.GetClient(),
 		Scheme:  mgr.GetScheme(),
 		Kclient: kclient,
-		Log:     ctrl.Log.WithName("controllers").WithName("DesignateAPI"),
+		Log:     ctrl.Log.WithName("controllers").WithName("DesignateSink"),
 	}).SetupWithManager(mgr); err != nil {
-		setupLog.Error(err, "unable to create controller", "controller", "DesignateAPI")
+		setupLog.Error(err, "unable to create controller", "controller", "DesignateSink")
+		os.Exit(1)
+	}
+	if err = (&controllers.DesignateZoneReconciler{
+		Client:  mgr.GetClient(),
+		Scheme:  mgr.GetScheme(),
+		Kclient: kclient,
+		Log:     ctrl.Log.WithName("controllers").WithName("DesignateZone"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "DesignateZone")
 		os.Exit(1)
 	}
+	if err = (&controllers.DesignateRecordSetReconciler{
+		Client:  mgr.GetClient(),
+		Scheme:  mgr.GetScheme(),
+		Kclient: kclient,
+		Log:     ctrl.Log.WithName("controllers").WithName("DesignateRecordSet"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "DesignateRecordSet")
+		os.Exit(1)
+	}
+	if err = (&controllers.DesignateQuotaReconciler{
+		Client:  mgr.GetClient(),
+		Scheme:  mgr.GetScheme(),
+		Kclient: kclient,
+		Log:     ctrl.Log.WithName("controllers").WithName("DesignateQuota"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "DesignateQuota")
+		os.Exit(1)
+	}
+	if err = (&controllers.DesignateTsigKeyReconciler{
+		Client:  mgr.GetClient(),
+		Scheme:  mgr.GetScheme(),
+		Kclient: kclient,
+		Log:     ctrl.Log.WithName("controllers").WithName("DesignateTsigKey"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "DesignateTsigKey")
+		os.Exit(1)
+	}
+	if err = (&controllers.DesignateBlacklistReconciler{
+		Client:  mgr.GetClient(),
+		Scheme:  mgr.GetScheme(),
+		Kclient: kclient,
+		Log:     ctrl.Log.WithName("controllers").WithName("DesignateBlacklist"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "DesignateBlacklist")
+		os.Exit(1)
+	}
+	if err = (&controllers.DesignateTLDReconciler{
+		Client:  mgr.GetClient(),
+		Scheme:  mgr.GetScheme(),
+		Kclient: kclient,
+		Log:     ctrl.Log.WithName("controllers").WithName("DesignateTLD"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "DesignateTLD")
+		os.Exit(1)
+	}
+
+	// the webhooks need the serving certificates, which are not available when running locally
+	if os.Getenv("ENABLE_WEBHOOKS") != "false" {
+		if err = (&designatev1.DesignateAPI{}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "DesignateAPI")
+			os.Exit(1)
+		}
+		if err = (&designatev1.DesignateBlacklist{}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "DesignateBlacklist")
+			os.Exit(1)
+		}
+	}
 	//+kubebuilder:scaffold:builder
 
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
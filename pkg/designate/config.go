@@ -0,0 +1,414 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package designate
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+
+	designatev1 "github.com/openstack-k8s-operators/designate-operator/api/v1beta1"
+	"github.com/openstack-k8s-operators/lib-common/modules/common/endpoint"
+	"github.com/openstack-k8s-operators/lib-common/modules/common/util"
+	corev1 "k8s.io/api/core/v1"
+)
+
+const (
+	// ServiceConfigFileName -
+	ServiceConfigFileName = "designate.conf"
+	// ServiceConfigTemplate - template of the designate.conf, relative to the templates dir
+	ServiceConfigTemplate = "designateapi/merged/" + ServiceConfigFileName
+	// MessagingConfigTemplate - template of the messaging config shared by all designate services,
+	// relative to the templates dir
+	MessagingConfigTemplate = "common/messaging.conf"
+
+	// NotificationsTopic - topic of the oslo.messaging notifications, which designate-sink consumes
+	NotificationsTopic = "notifications"
+	// TransportURLSecretKey - key of the transport_url in the TransportURLSecret of the DesignateAPI
+	// and the DesignateSink
+	TransportURLSecretKey = "transport_url"
+
+	// WSGIProcessesPerCore - mod_wsgi daemon processes per core of the CPU limit, the designate API
+	// mostly waits for the database and central, so one process would leave the core idle
+	WSGIProcessesPerCore = 2
+	// DefaultWSGIProcesses - mod_wsgi daemon processes without a CPU limit
+	DefaultWSGIProcesses = 5
+)
+
+// httpdLogLevels - httpd LogLevel of the python logging levels
+var httpdLogLevels = map[designatev1.LogLevel]string{
+	"DEBUG":    "debug",
+	"INFO":     "info",
+	"WARNING":  "warn",
+	"ERROR":    "error",
+	"CRITICAL": "crit",
+}
+
+// Logger - a python logger with a level overriding the root logger level
+type Logger struct {
+	// Key - name of the logger in the logging.conf, which can not contain dots
+	Key string
+	// Name - qualified name of the logger
+	Name  string
+	Level designatev1.LogLevel
+}
+
+// PolicyRule - an oslo.policy rule of the policy.yaml
+type PolicyRule struct {
+	// Name - name of the rule, quoted for YAML
+	Name string
+	// Check - check string of the rule, quoted for YAML
+	Check string
+}
+
+var (
+	iniSectionRegex = regexp.MustCompile(`^\[([^\]]+)\]\s*$`)
+	iniOptionRegex  = regexp.MustCompile(`^([^=:\s][^=:]*?)\s*[=:]\s*(.*)$`)
+)
+
+// RenderServiceConfig - renders the designate.conf template of a service using the templateParameters,
+// merges the messaging config shared by all designate services and then the customServiceConfig
+// over the result
+func RenderServiceConfig(
+	serviceConfigTemplate string,
+	templateParameters map[string]interface{},
+	customServiceConfig string,
+) (string, error) {
+	rendered, err := util.ExecuteTemplateFile(serviceConfigTemplate, templateParameters)
+	if err != nil {
+		return "", fmt.Errorf("error rendering %s: %w", ServiceConfigFileName, err)
+	}
+
+	messaging, err := util.ExecuteTemplateFile(MessagingConfigTemplate, templateParameters)
+	if err != nil {
+		return "", fmt.Errorf("error rendering %s: %w", MessagingConfigTemplate, err)
+	}
+
+	merged, err := MergeINI(rendered, messaging)
+	if err != nil {
+		return "", fmt.Errorf("error merging messaging config into %s: %w", ServiceConfigFileName, err)
+	}
+
+	merged, err = MergeINI(merged, customServiceConfig)
+	if err != nil {
+		return "", fmt.Errorf("error merging custom service config into %s: %w", ServiceConfigFileName, err)
+	}
+
+	return merged, nil
+}
+
+// TemplateParameters - returns the parameters of the config templates of the DesignateAPI, which
+// do not hold any passwords
+func TemplateParameters(
+	instance *designatev1.DesignateAPI,
+	keystoneInternalURL string,
+	keystonePublicURL string,
+	region string,
+) map[string]interface{} {
+	templateParameters := map[string]interface{}{
+		"ServiceUser":         instance.Spec.ServiceUser,
+		"KeystoneInternalURL": keystoneInternalURL,
+		"KeystonePublicURL":   keystonePublicURL,
+		"Region":              region,
+		"APIBaseURI":          instance.Status.APIEndpoints[string(endpoint.EndpointPublic)],
+		"MetricsEnabled":      instance.Spec.Metrics.Enabled,
+		"StatusPort":          DesignateStatusPort,
+		"NotificationsTopic":  NotificationsTopic,
+		"Quotas":              instance.Spec.Quotas,
+		"PolicyRules":         PolicyRules(instance.Spec.Policy),
+	}
+	for key, value := range LoggingTemplateParameters(instance.Spec.Logging) {
+		templateParameters[key] = value
+	}
+	for key, value := range APIServerTemplateParameters(instance.Spec.APIServer, instance.Spec.Resources) {
+		templateParameters[key] = value
+	}
+
+	return templateParameters
+}
+
+// SecretTemplateParameters - returns a copy of the templateParameters extended by the database
+// connection, the transport_url and the passwords, which must only get rendered into secrets
+func SecretTemplateParameters(
+	instance *designatev1.DesignateAPI,
+	templateParameters map[string]interface{},
+	databasePassword string,
+	servicePassword string,
+	transportURL string,
+) map[string]interface{} {
+	secretParameters := map[string]interface{}{}
+	for key, value := range templateParameters {
+		secretParameters[key] = value
+	}
+	secretParameters["DatabaseHost"] = instance.Status.DatabaseHostname
+	secretParameters["DatabaseUser"] = instance.Spec.DatabaseUser
+	secretParameters["DatabaseName"] = instance.Name
+	// the password is part of the SQLAlchemy URL, which unquotes it, so e.g. @, / or : in the
+	// password do not break the URL. A space must not become the + of the query escaping.
+	secretParameters["DatabasePassword"] = strings.ReplaceAll(url.QueryEscape(databasePassword), "+", "%20")
+	secretParameters["ServicePassword"] = servicePassword
+	secretParameters["TransportURL"] = transportURL
+
+	return secretParameters
+}
+
+// LoggingTemplateParameters - returns the template parameters for the logging.conf, httpd.conf and
+// designate.conf to configure the log format and levels of the logging spec
+func LoggingTemplateParameters(logging designatev1.DesignateAPILogging) map[string]interface{} {
+	level := logging.Level
+	if level == "" {
+		level = "INFO"
+	}
+
+	loggers := []Logger{}
+	for name, l := range logging.Loggers {
+		loggers = append(loggers, Logger{
+			Key:   strings.NewReplacer(".", "_", "-", "_").Replace(name),
+			Name:  name,
+			Level: l,
+		})
+	}
+	sort.Slice(loggers, func(i, j int) bool { return loggers[i].Name < loggers[j].Name })
+
+	return map[string]interface{}{
+		"Debug":         level == designatev1.LogLevelDebug,
+		"LogLevel":      level,
+		"LogFormatJSON": logging.Format == designatev1.LogFormatJSON,
+		"Loggers":       loggers,
+		"HttpdLogLevel": httpdLogLevels[level],
+	}
+}
+
+// APIServerTemplateParameters - returns the template parameters for the httpd.conf to tune the httpd
+// and mod_wsgi to the apiServer spec. Processes which are not set scale with the CPU limit.
+func APIServerTemplateParameters(
+	apiServer designatev1.DesignateAPIServer,
+	resources corev1.ResourceRequirements,
+) map[string]interface{} {
+	processes := int64(apiServer.Processes)
+	if processes == 0 {
+		processes = DefaultWSGIProcesses
+		if cpu, ok := resources.Limits[corev1.ResourceCPU]; ok && !cpu.IsZero() {
+			// round up, a limit of e.g. 500m still gets a process
+			processes = (cpu.MilliValue()*WSGIProcessesPerCore + 999) / 1000
+		}
+	}
+	threads := apiServer.Threads
+	if threads == 0 {
+		threads = 1
+	}
+	requestTimeout := apiServer.RequestTimeout
+	if requestTimeout == 0 {
+		requestTimeout = 60
+	}
+	maxRequestBodySize := apiServer.MaxRequestBodySize
+	if maxRequestBodySize == 0 {
+		maxRequestBodySize = 10485760
+	}
+
+	return map[string]interface{}{
+		"WSGIProcesses":      processes,
+		"WSGIThreads":        threads,
+		"RequestTimeout":     requestTimeout,
+		"MaxRequestBodySize": maxRequestBodySize,
+	}
+}
+
+// PolicyRules - returns the policy rules sorted by name, with the names and check strings quoted
+// as JSON strings, which are valid YAML scalars whatever characters the check strings contain
+func PolicyRules(policy map[string]string) []PolicyRule {
+	rules := []PolicyRule{}
+	for name, check := range policy {
+		quotedName, _ := json.Marshal(name)
+		quotedCheck, _ := json.Marshal(check)
+		rules = append(rules, PolicyRule{Name: string(quotedName), Check: string(quotedCheck)})
+	}
+	sort.Slice(rules, func(i, j int) bool { return rules[i].Name < rules[j].Name })
+
+	return rules
+}
+
+// MergeINI - merges the options of the override INI data over the base INI data.
+// All values of an option in base get replaced by the values of the same option in
+// override, options and sections which do not exist in base get appended. Comments
+// and ordering of base are preserved.
+func MergeINI(base string, override string) (string, error) {
+	baseFile, err := parseINI(base)
+	if err != nil {
+		return "", err
+	}
+	overrideFile, err := parseINI(override)
+	if err != nil {
+		return "", err
+	}
+
+	for _, s := range overrideFile.sections {
+		if s.name == "" {
+			// only comments and blank lines are allowed before the first section
+			continue
+		}
+		target := baseFile.getSection(s.name)
+		if target == nil {
+			target = baseFile.addSection(s.name)
+		}
+		for _, key := range s.keys() {
+			target.setOption(key, s.getOptions(key))
+		}
+	}
+
+	return baseFile.String(), nil
+}
+
+// iniFile - ordered representation of an oslo.config style INI file
+type iniFile struct {
+	sections []*iniSection
+}
+
+// iniSection - a section of an iniFile, the unnamed section holds the lines before
+// the first section header
+type iniSection struct {
+	name    string
+	entries []iniEntry
+}
+
+// iniEntry - a single option or a comment/blank line, in which case key is empty
+type iniEntry struct {
+	key   string
+	lines []string
+}
+
+func parseINI(data string) (*iniFile, error) {
+	f := &iniFile{sections: []*iniSection{{}}}
+	current := f.sections[0]
+
+	for i, line := range strings.Split(strings.TrimRight(data, "\n"), "\n") {
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case trimmed == "" || strings.HasPrefix(trimmed, "#") || strings.HasPrefix(trimmed, ";"):
+			current.entries = append(current.entries, iniEntry{lines: []string{line}})
+		case line != strings.TrimLeft(line, " \t"):
+			// continuation of a multi line value
+			last := len(current.entries) - 1
+			if last < 0 || current.entries[last].key == "" {
+				return nil, fmt.Errorf("line %d: unexpected continuation line %q", i+1, line)
+			}
+			current.entries[last].lines = append(current.entries[last].lines, line)
+		case iniSectionRegex.MatchString(trimmed):
+			current = &iniSection{name: iniSectionRegex.FindStringSubmatch(trimmed)[1]}
+			f.sections = append(f.sections, current)
+		case iniOptionRegex.MatchString(trimmed):
+			if current.name == "" {
+				return nil, fmt.Errorf("line %d: option %q outside of a section", i+1, trimmed)
+			}
+			key := iniOptionRegex.FindStringSubmatch(trimmed)[1]
+			current.entries = append(current.entries, iniEntry{key: key, lines: []string{line}})
+		default:
+			return nil, fmt.Errorf("line %d: invalid line %q", i+1, line)
+		}
+	}
+
+	return f, nil
+}
+
+func (f *iniFile) getSection(name string) *iniSection {
+	for _, s := range f.sections {
+		if s.name == name {
+			return s
+		}
+	}
+	return nil
+}
+
+func (f *iniFile) addSection(name string) *iniSection {
+	// keep an empty line between the previous and the new section
+	last := f.sections[len(f.sections)-1]
+	if n := len(last.entries); n > 0 && strings.TrimSpace(last.entries[n-1].lines[0]) != "" {
+		last.entries = append(last.entries, iniEntry{lines: []string{""}})
+	}
+
+	s := &iniSection{name: name}
+	f.sections = append(f.sections, s)
+	return s
+}
+
+// String - renders the iniFile
+func (f *iniFile) String() string {
+	var b strings.Builder
+	for _, s := range f.sections {
+		if s.name != "" {
+			b.WriteString("[" + s.name + "]\n")
+		}
+		for _, e := range s.entries {
+			for _, l := range e.lines {
+				b.WriteString(l + "\n")
+			}
+		}
+	}
+	return b.String()
+}
+
+// keys - returns the option names of the section in order of their first occurrence
+func (s *iniSection) keys() []string {
+	keys := []string{}
+	seen := map[string]bool{}
+	for _, e := range s.entries {
+		if e.key != "" && !seen[e.key] {
+			seen[e.key] = true
+			keys = append(keys, e.key)
+		}
+	}
+	return keys
+}
+
+// getOptions - returns all entries of the option key
+func (s *iniSection) getOptions(key string) []iniEntry {
+	options := []iniEntry{}
+	for _, e := range s.entries {
+		if e.key == key {
+			options = append(options, e)
+		}
+	}
+	return options
+}
+
+// setOption - replaces all entries of the option key with options. The options are
+// placed where the option was first found, or at the end of the section.
+func (s *iniSection) setOption(key string, options []iniEntry) {
+	entries := []iniEntry{}
+	insertAt := -1
+	for _, e := range s.entries {
+		if e.key == key {
+			if insertAt < 0 {
+				insertAt = len(entries)
+			}
+			continue
+		}
+		entries = append(entries, e)
+	}
+
+	if insertAt < 0 {
+		// add new options before the trailing blank lines of the section
+		insertAt = len(entries)
+		for insertAt > 0 && entries[insertAt-1].key == "" && strings.TrimSpace(entries[insertAt-1].lines[0]) == "" {
+			insertAt--
+		}
+	}
+
+	s.entries = append(entries[:insertAt], append(options, entries[insertAt:]...)...)
+}
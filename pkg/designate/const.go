@@ -22,8 +22,8 @@ const (
 	ServiceType = "network-dns"
 	// ServiceAccount -
 	ServiceAccount = "designate-operator-designate"
-	// DatabaseName -
-	DatabaseName = "designate"
+	// OwnerNameLabel - label holding the name of the DesignateAPI which owns an object
+	OwnerNameLabel = "designate.openstack.org/name"
 
 	// DesignateAdminPort -
 	DesignateAdminPort int32 = 9611
@@ -32,8 +32,13 @@ const (
 	// DesignateInternalPort -
 	DesignateInternalPort int32 = 9611
 
+	// DesignateStatusPort - localhost only httpd port serving the mod_status page for the metrics exporter
+	DesignateStatusPort int32 = 9612
+	// DesignateMetricsPort - port of the metrics exporter sidecar
+	DesignateMetricsPort int32 = 9117
+
 	// KollaDbSyncConfig -
-	KollaDbSyncConfig = "/var/lib/config-data/merged/designate-api-db-sync.json"
+	KollaDbSyncConfig = "/var/lib/config-data/default/designate-api-db-sync.json"
 	// KollaConfig -
-	KollaConfig = "/var/lib/config-data/merged/designate-api-config.json"
+	KollaConfig = "/var/lib/config-data/default/designate-api-config.json"
 )
@@ -36,7 +36,6 @@ func DbSyncJob(
 	labels map[string]string,
 ) *batchv1.Job {
 	runAsUser := int64(0)
-	initVolumeMounts := getInitVolumeMounts()
 	volumeMounts := getVolumeMounts()
 	volumes := getVolumes(instance.Name)
 
@@ -54,7 +53,7 @@ func DbSyncJob(
 
 	job := &batchv1.Job{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      ServiceName + "-db-sync",
+			Name:      instance.Name + "-db-sync",
 			Namespace: instance.Namespace,
 			Labels:    labels,
 		},
@@ -84,17 +83,5 @@ func DbSyncJob(
 		},
 	}
 
-	initContainerDetails := APIDetails{
-		ContainerImage:       instance.Spec.ContainerImage,
-		DatabaseHost:         instance.Status.DatabaseHostname,
-		DatabaseUser:         instance.Spec.DatabaseUser,
-		DatabaseName:         DatabaseName,
-		OSPSecret:            instance.Spec.Secret,
-		DBPasswordSelector:   instance.Spec.PasswordSelectors.Database,
-		UserPasswordSelector: instance.Spec.PasswordSelectors.Service,
-		VolumeMounts:         initVolumeMounts,
-	}
-	job.Spec.Template.Spec.InitContainers = initContainer(initContainerDetails)
-
 	return job
 }
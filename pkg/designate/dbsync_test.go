@@ -0,0 +1,60 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package designate
+
+import (
+	"testing"
+
+	designatev1 "github.com/openstack-k8s-operators/designate-operator/api/v1beta1"
+)
+
+func TestDbSyncJob(t *testing.T) {
+	tests := []struct {
+		name   string
+		golden string
+		mutate func(instance *designatev1.DesignateAPI)
+	}{
+		{
+			name:   "default",
+			golden: "dbsync/default.yaml",
+			mutate: func(instance *designatev1.DesignateAPI) {},
+		},
+		{
+			name:   "debug db sync",
+			golden: "dbsync/debug.yaml",
+			mutate: func(instance *designatev1.DesignateAPI) {
+				instance.Spec.Debug.DBSync = true
+			},
+		},
+		{
+			// the service debug flag must not change the job
+			name:   "debug service",
+			golden: "dbsync/default.yaml",
+			mutate: func(instance *designatev1.DesignateAPI) {
+				instance.Spec.Debug.Service = true
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			instance := newTestInstance()
+			tc.mutate(instance)
+
+			assertGoldenYAML(t, tc.golden, DbSyncJob(instance, testLabels))
+		})
+	}
+}
@@ -39,7 +39,6 @@ func Deployment(
 	labels map[string]string,
 ) *appsv1.Deployment {
 	runAsUser := int64(0)
-	initVolumeMounts := getInitVolumeMounts()
 	volumeMounts := getVolumeMounts()
 	volumes := getVolumes(instance.Name)
 
@@ -94,7 +93,7 @@ func Deployment(
 	// TODO(tweining): Implement container deployment
 	deployment := &appsv1.Deployment{
 		ObjectMeta: metav1.ObjectMeta{
-			Name:      ServiceName,
+			Name:      instance.Name,
 			Namespace: instance.Namespace,
 		},
 		Spec: appsv1.DeploymentSpec{
@@ -131,13 +130,20 @@ func Deployment(
 			},
 		},
 	}
+	if instance.Spec.Metrics.Enabled {
+		deployment.Spec.Template.Spec.Containers = append(
+			deployment.Spec.Template.Spec.Containers,
+			metricsExporterContainer(instance),
+		)
+	}
+
 	// If possible two pods of the same service should not
 	// run on the same worker node. If this is not possible
 	// the get still created on the same worker node.
 	deployment.Spec.Template.Spec.Affinity = affinity.DistributePods(
-		common.AppSelector,
+		OwnerNameLabel,
 		[]string{
-			ServiceName,
+			instance.Name,
 		},
 		corev1.LabelHostname,
 	)
@@ -145,17 +151,5 @@ func Deployment(
 		deployment.Spec.Template.Spec.NodeSelector = instance.Spec.NodeSelector
 	}
 
-	initContainerDetails := APIDetails{
-		ContainerImage:       instance.Spec.ContainerImage,
-		DatabaseHost:         instance.Status.DatabaseHostname,
-		DatabaseUser:         instance.Spec.DatabaseUser,
-		DatabaseName:         DatabaseName,
-		OSPSecret:            instance.Spec.Secret,
-		DBPasswordSelector:   instance.Spec.PasswordSelectors.Database,
-		UserPasswordSelector: instance.Spec.PasswordSelectors.Service,
-		VolumeMounts:         initVolumeMounts,
-	}
-	deployment.Spec.Template.Spec.InitContainers = initContainer(initContainerDetails)
-
 	return deployment
 }
@@ -0,0 +1,88 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package designate
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	designatev1 "github.com/openstack-k8s-operators/designate-operator/api/v1beta1"
+)
+
+func TestDeployment(t *testing.T) {
+	tests := []struct {
+		name   string
+		golden string
+		mutate func(instance *designatev1.DesignateAPI)
+	}{
+		{
+			name:   "default",
+			golden: "deployment/default.yaml",
+			mutate: func(instance *designatev1.DesignateAPI) {},
+		},
+		{
+			name:   "debug service",
+			golden: "deployment/debug.yaml",
+			mutate: func(instance *designatev1.DesignateAPI) {
+				instance.Spec.Debug.Service = true
+			},
+		},
+		{
+			name:   "node selector and replicas",
+			golden: "deployment/node-selector.yaml",
+			mutate: func(instance *designatev1.DesignateAPI) {
+				instance.Spec.Replicas = 3
+				instance.Spec.NodeSelector = map[string]string{
+					"node-role.kubernetes.io/worker": "",
+				}
+			},
+		},
+		{
+			name:   "resources",
+			golden: "deployment/resources.yaml",
+			mutate: func(instance *designatev1.DesignateAPI) {
+				instance.Spec.Resources = corev1.ResourceRequirements{
+					Limits: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("2"),
+						corev1.ResourceMemory: resource.MustParse("1Gi"),
+					},
+					Requests: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("500m"),
+						corev1.ResourceMemory: resource.MustParse("256Mi"),
+					},
+				}
+			},
+		},
+		{
+			name:   "metrics exporter",
+			golden: "deployment/metrics.yaml",
+			mutate: func(instance *designatev1.DesignateAPI) {
+				instance.Spec.Metrics.Enabled = true
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			instance := newTestInstance()
+			tc.mutate(instance)
+
+			assertGoldenYAML(t, tc.golden, Deployment(instance, "n5b8h5c9h", testLabels))
+		})
+	}
+}
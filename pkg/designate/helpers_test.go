@@ -0,0 +1,140 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package designate
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	designatev1 "github.com/openstack-k8s-operators/designate-operator/api/v1beta1"
+	"github.com/openstack-k8s-operators/lib-common/modules/common"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+// update - regenerates the golden files from the current output, run with
+// go test ./pkg/designate/... -update
+var update = flag.Bool("update", false, "update the golden files in testdata")
+
+// TestMain - points the lib-common template helpers to the templates of the operator
+func TestMain(m *testing.M) {
+	templates, err := filepath.Abs(filepath.Join("..", "..", "templates"))
+	if err != nil {
+		panic(err)
+	}
+	if err := os.Setenv("OPERATOR_TEMPLATES", templates+"/"); err != nil {
+		panic(err)
+	}
+
+	os.Exit(m.Run())
+}
+
+// assertGolden - compares actual with the content of testdata/<name>, or writes
+// actual to the file when the tests run with -update
+func assertGolden(t *testing.T, name string, actual string) {
+	t.Helper()
+
+	golden := filepath.Join("testdata", name)
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(golden), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(golden, []byte(actual), 0644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+
+	expected, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatalf("%v, run the tests with -update to create the golden file", err)
+	}
+	if diff := cmp.Diff(string(expected), actual); diff != "" {
+		t.Errorf("%s mismatch (-expected +actual):\n%s", golden, diff)
+	}
+}
+
+// assertGoldenYAML - marshals obj to YAML and compares it with testdata/<name>
+func assertGoldenYAML(t *testing.T, name string, obj interface{}) {
+	t.Helper()
+
+	actual, err := yaml.Marshal(obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertGolden(t, name, string(actual))
+}
+
+// newTestInstance - returns a DesignateAPI as it gets passed to the builders after the defaulting
+// of the API server and the reconcile steps before the deployment
+func newTestInstance() *designatev1.DesignateAPI {
+	return &designatev1.DesignateAPI{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "designate-api",
+			Namespace: "openstack",
+		},
+		Spec: designatev1.DesignateAPISpec{
+			DatabaseInstance: "openstack",
+			DatabaseUser:     "designate",
+			ServiceUser:      "designate",
+			ContainerImage:   "quay.io/tripleozedcentos9/openstack-designate-api:current-tripleo",
+			Replicas:         1,
+			Secret:           "osp-secret",
+			PasswordSelectors: designatev1.PasswordSelector{
+				Database: "DesignateDatabasePassword",
+				Service:  "DesignatePassword",
+			},
+			Metrics: designatev1.DesignateAPIMetrics{
+				ContainerImage: "quay.io/prometheuscommunity/apache-exporter:v0.13.0",
+			},
+			MinReadyPercent: 100,
+			NeutronDNS: designatev1.DesignateAPINeutronDNS{
+				AllowReverseDNSLookup: true,
+				IPv4PTRZonePrefixSize: 24,
+				IPv6PTRZonePrefixSize: 116,
+			},
+			Quotas: designatev1.DesignateAPIQuotas{
+				Zones:            10,
+				ZoneRecordsets:   500,
+				ZoneRecords:      500,
+				RecordsetRecords: 20,
+				APIExportSize:    1000,
+			},
+			APIServer: designatev1.DesignateAPIServer{
+				Threads:            1,
+				RequestTimeout:     60,
+				MaxRequestBodySize: 10485760,
+			},
+		},
+		Status: designatev1.DesignateAPIStatus{
+			DatabaseHostname: "openstack.openstack.svc",
+			APIEndpoints: map[string]string{
+				"internal": "http://designate-internal.openstack.svc:9001",
+				"public":   "http://designate-public-openstack.apps-crc.testing",
+			},
+		},
+	}
+}
+
+// testLabels - the labels the controller passes to the builders
+var testLabels = map[string]string{
+	common.AppSelector: ServiceName,
+	OwnerNameLabel:     "designate-api",
+}
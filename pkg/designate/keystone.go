@@ -0,0 +1,151 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package designate
+
+import (
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/endpoints"
+	"github.com/gophercloud/gophercloud/openstack/identity/v3/regions"
+	"github.com/openstack-k8s-operators/lib-common/modules/openstack"
+)
+
+// The KeystoneEndpoint CR registers the endpoints in the region of the KeystoneAPI. Endpoints
+// for other regions get registered using the keystone admin client directly.
+
+// EnsureRegionEndpoints - creates the region if it does not exist and creates or updates the
+// endpoints of the service in the region. endpointIDs holds the IDs of the endpoints registered
+// before, an endpoint registered by someone else only gets reused if it has the same URL.
+// Returns the endpoint IDs with the endpoint type as index.
+func EnsureRegionEndpoints(
+	log logr.Logger,
+	os *openstack.OpenStack,
+	region string,
+	serviceID string,
+	apiEndpoints map[string]string,
+	endpointIDs map[string]string,
+) (map[string]string, error) {
+	client := os.GetOSClient()
+
+	_, err := regions.Get(client, region).Extract()
+	if err != nil {
+		if _, ok := err.(gophercloud.ErrDefault404); !ok {
+			return nil, err
+		}
+		_, err = regions.Create(client, regions.CreateOpts{ID: region}).Extract()
+		if err != nil {
+			return nil, err
+		}
+		log.Info(fmt.Sprintf("Created region %s", region))
+	}
+
+	registeredIDs := map[string]string{}
+	for endpointType, url := range apiEndpoints {
+		availability, err := openstack.GetAvailability(endpointType)
+		if err != nil {
+			return nil, err
+		}
+
+		regionEndpoints, err := getRegionEndpoints(client, region, serviceID, availability)
+		if err != nil {
+			return nil, err
+		}
+
+		var endpt *endpoints.Endpoint
+		for i := range regionEndpoints {
+			if regionEndpoints[i].ID == endpointIDs[endpointType] || regionEndpoints[i].URL == url {
+				endpt = &regionEndpoints[i]
+				break
+			}
+		}
+
+		if endpt == nil {
+			if len(regionEndpoints) > 0 {
+				return nil, fmt.Errorf("%s endpoint of region %s is already registered with URL %s",
+					endpointType, region, regionEndpoints[0].URL)
+			}
+			created, err := endpoints.Create(client, endpoints.CreateOpts{
+				Availability: availability,
+				Name:         ServiceName,
+				Region:       region,
+				ServiceID:    serviceID,
+				URL:          url,
+			}).Extract()
+			if err != nil {
+				return nil, err
+			}
+			log.Info(fmt.Sprintf("Created %s endpoint %s in region %s", endpointType, url, region))
+			registeredIDs[endpointType] = created.ID
+			continue
+		}
+
+		if endpt.URL != url {
+			_, err := endpoints.Update(client, endpt.ID, endpoints.UpdateOpts{
+				URL: url,
+			}).Extract()
+			if err != nil {
+				return nil, err
+			}
+			log.Info(fmt.Sprintf("Updated %s endpoint %s in region %s", endpointType, url, region))
+		}
+		registeredIDs[endpointType] = endpt.ID
+	}
+
+	return registeredIDs, nil
+}
+
+// DeleteRegionEndpoints - deletes the endpoints with the IDs registered in the region
+func DeleteRegionEndpoints(
+	log logr.Logger,
+	os *openstack.OpenStack,
+	region string,
+	endpointIDs map[string]string,
+) error {
+	client := os.GetOSClient()
+
+	for endpointType, id := range endpointIDs {
+		err := endpoints.Delete(client, id).ExtractErr()
+		if err != nil {
+			if _, ok := err.(gophercloud.ErrDefault404); ok {
+				continue
+			}
+			return err
+		}
+		log.Info(fmt.Sprintf("Deleted %s endpoint %s in region %s", endpointType, id, region))
+	}
+
+	return nil
+}
+
+func getRegionEndpoints(
+	client *gophercloud.ServiceClient,
+	region string,
+	serviceID string,
+	availability gophercloud.Availability,
+) ([]endpoints.Endpoint, error) {
+	allPages, err := endpoints.List(client, endpoints.ListOpts{
+		Availability: availability,
+		RegionID:     region,
+		ServiceID:    serviceID,
+	}).AllPages()
+	if err != nil {
+		return nil, err
+	}
+
+	return endpoints.ExtractEndpoints(allPages)
+}
@@ -0,0 +1,135 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package designate
+
+import (
+	"fmt"
+
+	designatev1 "github.com/openstack-k8s-operators/designate-operator/api/v1beta1"
+	"github.com/openstack-k8s-operators/lib-common/modules/common/service"
+	"github.com/openstack-k8s-operators/lib-common/modules/common/util"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+const (
+	// MetricsPortName - name of the exporter port in the pod and the metrics service
+	MetricsPortName = "metrics"
+	// MetricsLabel - label of the metrics service, to not select the API services with the ServiceMonitor
+	MetricsLabel = "designate.openstack.org/metrics"
+)
+
+// ServiceMonitorGVK - the prometheus-operator ServiceMonitor, handled as unstructured object
+// to not depend on the prometheus-operator API
+var ServiceMonitorGVK = schema.GroupVersionKind{
+	Group:   "monitoring.coreos.com",
+	Version: "v1",
+	Kind:    "ServiceMonitor",
+}
+
+// MetricsName - name of the metrics Service and ServiceMonitor of the instance
+func MetricsName(instance *designatev1.DesignateAPI) string {
+	return instance.Name + "-metrics"
+}
+
+// metricsLabels - labels of the metrics service
+func metricsLabels(labels map[string]string) map[string]string {
+	return util.MergeStringMaps(labels, map[string]string{MetricsLabel: "true"})
+}
+
+// metricsExporterContainer - sidecar which converts the httpd mod_status page to metrics
+func metricsExporterContainer(instance *designatev1.DesignateAPI) corev1.Container {
+	probe := &corev1.Probe{
+		TimeoutSeconds:      5,
+		PeriodSeconds:       15,
+		InitialDelaySeconds: 5,
+		ProbeHandler: corev1.ProbeHandler{
+			HTTPGet: &corev1.HTTPGetAction{
+				Path: "/metrics",
+				Port: intstr.FromInt(int(DesignateMetricsPort)),
+			},
+		},
+	}
+
+	return corev1.Container{
+		Name:  ServiceName + "-api-metrics",
+		Image: instance.Spec.Metrics.ContainerImage,
+		Args: []string{
+			fmt.Sprintf("--scrape_uri=http://127.0.0.1:%d/server-status?auto", DesignateStatusPort),
+			fmt.Sprintf("--telemetry.address=:%d", DesignateMetricsPort),
+		},
+		Ports: []corev1.ContainerPort{
+			{
+				Name:          MetricsPortName,
+				ContainerPort: DesignateMetricsPort,
+				Protocol:      corev1.ProtocolTCP,
+			},
+		},
+		LivenessProbe: probe,
+	}
+}
+
+// MetricsService - service in front of the metrics exporter sidecars
+func MetricsService(
+	instance *designatev1.DesignateAPI,
+	labels map[string]string,
+) *corev1.Service {
+	return service.GenericService(&service.GenericServiceDetails{
+		Name:      MetricsName(instance),
+		Namespace: instance.Namespace,
+		Labels:    metricsLabels(labels),
+		Selector:  labels,
+		Port: service.GenericServicePort{
+			Name:     MetricsPortName,
+			Port:     DesignateMetricsPort,
+			Protocol: corev1.ProtocolTCP,
+		},
+	})
+}
+
+// ServiceMonitor - ServiceMonitor scraping the metrics service of the instance
+func ServiceMonitor(
+	instance *designatev1.DesignateAPI,
+	labels map[string]string,
+) *unstructured.Unstructured {
+	matchLabels := map[string]interface{}{}
+	for k, v := range metricsLabels(labels) {
+		matchLabels[k] = v
+	}
+
+	serviceMonitor := &unstructured.Unstructured{}
+	serviceMonitor.SetGroupVersionKind(ServiceMonitorGVK)
+	serviceMonitor.SetName(MetricsName(instance))
+	serviceMonitor.SetNamespace(instance.Namespace)
+	serviceMonitor.SetLabels(metricsLabels(labels))
+	serviceMonitor.Object["spec"] = map[string]interface{}{
+		"endpoints": []interface{}{
+			map[string]interface{}{
+				"port":     MetricsPortName,
+				"path":     "/metrics",
+				"interval": "30s",
+			},
+		},
+		"selector": map[string]interface{}{
+			"matchLabels": matchLabels,
+		},
+	}
+
+	return serviceMonitor
+}
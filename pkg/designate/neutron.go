@@ -0,0 +1,68 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package designate
+
+import (
+	"fmt"
+
+	designatev1 "github.com/openstack-k8s-operators/designate-operator/api/v1beta1"
+	"github.com/openstack-k8s-operators/lib-common/modules/common/endpoint"
+	"github.com/openstack-k8s-operators/lib-common/modules/common/util"
+)
+
+const (
+	// NeutronDNSConfigFileName - name of the neutron config snippet in the neutron DNS secret
+	NeutronDNSConfigFileName = "neutron-designate.conf"
+	// NeutronDNSConfigTemplate - template of the neutron config snippet, relative to the templates dir
+	NeutronDNSConfigTemplate = "designateapi/neutron/" + NeutronDNSConfigFileName
+)
+
+// NeutronDNSSecretName - returns the name of the secret holding the neutron config snippet
+func NeutronDNSSecretName(instance *designatev1.DesignateAPI) string {
+	return instance.Name + "-neutron-dns"
+}
+
+// RenderNeutronDNSConfig - renders the [designate] section neutron needs to use designate as its
+// external DNS driver. Neutron talks to the internal endpoint of the v2 API.
+func RenderNeutronDNSConfig(
+	instance *designatev1.DesignateAPI,
+	keystoneInternalURL string,
+	region string,
+	servicePassword string,
+) (string, error) {
+	internalURL, ok := instance.Status.APIEndpoints[string(endpoint.EndpointInternal)]
+	if !ok {
+		return "", fmt.Errorf("%s endpoint of %s not exposed yet", endpoint.EndpointInternal, instance.Name)
+	}
+
+	templateParameters := map[string]interface{}{
+		"URL":                   internalURL + "/v2",
+		"KeystoneInternalURL":   keystoneInternalURL,
+		"ServiceUser":           instance.Spec.ServiceUser,
+		"ServicePassword":       servicePassword,
+		"Region":                region,
+		"AllowReverseDNSLookup": instance.Spec.NeutronDNS.AllowReverseDNSLookup,
+		"IPv4PTRZonePrefixSize": instance.Spec.NeutronDNS.IPv4PTRZonePrefixSize,
+		"IPv6PTRZonePrefixSize": instance.Spec.NeutronDNS.IPv6PTRZonePrefixSize,
+	}
+
+	rendered, err := util.ExecuteTemplateFile(NeutronDNSConfigTemplate, templateParameters)
+	if err != nil {
+		return "", fmt.Errorf("error rendering %s: %w", NeutronDNSConfigFileName, err)
+	}
+
+	return rendered, nil
+}
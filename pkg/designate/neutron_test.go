@@ -0,0 +1,75 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package designate
+
+import (
+	"path"
+	"testing"
+
+	designatev1 "github.com/openstack-k8s-operators/designate-operator/api/v1beta1"
+)
+
+// TestRenderNeutronDNSConfig - compares the neutron config snippet with the golden files in
+// testdata/neutron/
+func TestRenderNeutronDNSConfig(t *testing.T) {
+	tests := []struct {
+		name   string
+		mutate func(instance *designatev1.DesignateAPI)
+	}{
+		{
+			name:   "default",
+			mutate: func(instance *designatev1.DesignateAPI) {},
+		},
+		{
+			name: "no-reverse-dns",
+			mutate: func(instance *designatev1.DesignateAPI) {
+				instance.Spec.NeutronDNS.AllowReverseDNSLookup = false
+				instance.Spec.NeutronDNS.IPv4PTRZonePrefixSize = 16
+				instance.Spec.NeutronDNS.IPv6PTRZonePrefixSize = 64
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			instance := newTestInstance()
+			instance.Spec.NeutronDNS.Enabled = true
+			tc.mutate(instance)
+
+			rendered, err := RenderNeutronDNSConfig(
+				instance,
+				"http://keystone-internal.openstack.svc:5000",
+				"regionOne",
+				"service-password",
+			)
+			if err != nil {
+				t.Fatal(err)
+			}
+			assertGolden(t, path.Join("neutron", tc.name+".conf"), rendered)
+		})
+	}
+}
+
+// TestRenderNeutronDNSConfigWithoutEndpoint - the snippet can not be rendered before the
+// internal endpoint got exposed
+func TestRenderNeutronDNSConfigWithoutEndpoint(t *testing.T) {
+	instance := newTestInstance()
+	instance.Status.APIEndpoints = map[string]string{}
+
+	if _, err := RenderNeutronDNSConfig(instance, "internal", "regionOne", "service-password"); err == nil {
+		t.Error("expected an error without internal endpoint")
+	}
+}
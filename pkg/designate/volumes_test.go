@@ -0,0 +1,57 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package designate
+
+import (
+	"testing"
+)
+
+func TestGetVolumes(t *testing.T) {
+	tests := []struct {
+		name   string
+		golden string
+	}{
+		{
+			name:   "designate-api",
+			golden: "volumes/designate-api.yaml",
+		},
+		{
+			name:   "designate-api-cell2",
+			golden: "volumes/designate-api-cell2.yaml",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assertGoldenYAML(t, tc.golden, getVolumes(tc.name))
+		})
+	}
+}
+
+func TestGetVolumeMounts(t *testing.T) {
+	mounts := getVolumeMounts()
+	assertGoldenYAML(t, "volumes/mounts.yaml", mounts)
+
+	volumes := map[string]bool{}
+	for _, v := range getVolumes("designate-api") {
+		volumes[v.Name] = true
+	}
+	for _, m := range mounts {
+		if !volumes[m.Name] {
+			t.Errorf("volume mount %s does not reference a volume", m.Name)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package designateblacklist
+
+import (
+	"github.com/gophercloud/gophercloud"
+	designatev1 "github.com/openstack-k8s-operators/designate-operator/api/v1beta1"
+	"github.com/openstack-k8s-operators/designate-operator/pkg/designateclient"
+)
+
+// blacklists - the blacklist entries of designate, identified by their pattern
+var blacklists = designateclient.EntryCollection{
+	Name:         "blacklists",
+	KeyAttribute: "pattern",
+	EqualKeys:    EqualPatterns,
+}
+
+// EqualPatterns - returns true if both patterns identify the same blacklist entry, designate
+// compares the patterns exactly
+func EqualPatterns(a string, b string) bool {
+	return a == b
+}
+
+// EnsureBlacklist - creates the blacklist entry of the DesignateBlacklist, or adopts an entry with the
+// same pattern which already exists. Corrects the pattern and description if they drifted from the
+// spec. Returns the entry as stored in designate and whether it existed before, without being the
+// entry of the Status.BlacklistID.
+func EnsureBlacklist(
+	client *gophercloud.ServiceClient,
+	instance *designatev1.DesignateBlacklist,
+) (*designateclient.Entry, bool, error) {
+	return blacklists.Ensure(client, instance.Status.BlacklistID, instance.Spec.Pattern, instance.Spec.Description)
+}
+
+// DeleteBlacklist - deletes the blacklist entry, an entry which does not exist anymore is ignored
+func DeleteBlacklist(client *gophercloud.ServiceClient, blacklistID string) error {
+	return blacklists.Delete(client, blacklistID)
+}
@@ -0,0 +1,142 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package designateblacklist
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	designatev1 "github.com/openstack-k8s-operators/designate-operator/api/v1beta1"
+	"github.com/openstack-k8s-operators/designate-operator/pkg/designateclient"
+	"github.com/openstack-k8s-operators/designate-operator/pkg/designateclient/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newTestClient(t *testing.T) (*fake.Server, *gophercloud.ServiceClient) {
+	t.Helper()
+
+	server := fake.NewServer()
+	t.Cleanup(server.Close)
+
+	client, err := designateclient.NewClient(server.AuthOpts(), server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return server, client
+}
+
+func newTestInstance() *designatev1.DesignateBlacklist {
+	return &designatev1.DesignateBlacklist{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "internal-corp",
+			Namespace: "openstack",
+		},
+		Spec: designatev1.DesignateBlacklistSpec{
+			DesignateAPI: "designate",
+			Pattern:      `^([A-Za-z0-9_\-]+\.)*internal\.corp\.$`,
+			Description:  "zones of the internal DNS",
+		},
+	}
+}
+
+func TestEnsureBlacklistCreates(t *testing.T) {
+	server, client := newTestClient(t)
+
+	blacklist, adopted, err := EnsureBlacklist(client, newTestInstance())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if adopted {
+		t.Error("created entry reported as adopted")
+	}
+
+	expected := fake.Blacklist{
+		ID:          blacklist.ID,
+		Pattern:     `^([A-Za-z0-9_\-]+\.)*internal\.corp\.$`,
+		Description: "zones of the internal DNS",
+	}
+	if actual := server.GetBlacklist(blacklist.ID); actual == nil || *actual != expected {
+		t.Errorf("unexpected entry %+v", actual)
+	}
+}
+
+func TestEnsureBlacklistUpdates(t *testing.T) {
+	server, client := newTestClient(t)
+	instance := newTestInstance()
+
+	blacklist, _, err := EnsureBlacklist(client, instance)
+	if err != nil {
+		t.Fatal(err)
+	}
+	instance.Status.BlacklistID = blacklist.ID
+
+	instance.Spec.Pattern = `^([A-Za-z0-9_\-]+\.)*corp\.$`
+	instance.Spec.Description = ""
+	blacklist, adopted, err := EnsureBlacklist(client, instance)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if adopted || blacklist.ID != instance.Status.BlacklistID {
+		t.Errorf("unexpected entry %+v, adopted %t", blacklist, adopted)
+	}
+	actual := server.GetBlacklist(blacklist.ID)
+	if actual.Pattern != instance.Spec.Pattern || actual.Description != "" {
+		t.Errorf("entry not updated %+v", actual)
+	}
+}
+
+func TestEnsureBlacklistAdoptsAndRecreates(t *testing.T) {
+	server, client := newTestClient(t)
+	instance := newTestInstance()
+	id := server.AddBlacklist(fake.Blacklist{Pattern: instance.Spec.Pattern})
+
+	blacklist, adopted, err := EnsureBlacklist(client, instance)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !adopted || blacklist.ID != id || server.GetBlacklist(id).Description != instance.Spec.Description {
+		t.Errorf("entry not adopted %+v", blacklist)
+	}
+	instance.Status.BlacklistID = id
+
+	server.DeleteBlacklist(id)
+	blacklist, adopted, err = EnsureBlacklist(client, instance)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if adopted || blacklist.ID == id || server.GetBlacklist(blacklist.ID) == nil {
+		t.Errorf("entry not created again %+v", blacklist)
+	}
+}
+
+func TestDeleteBlacklist(t *testing.T) {
+	server, client := newTestClient(t)
+
+	blacklist, _, err := EnsureBlacklist(client, newTestInstance())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := DeleteBlacklist(client, blacklist.ID); err != nil {
+		t.Fatal(err)
+	}
+	if server.GetBlacklist(blacklist.ID) != nil {
+		t.Error("entry not deleted")
+	}
+	// deleting an entry which does not exist anymore succeeds
+	if err := DeleteBlacklist(client, blacklist.ID); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,115 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package designateclient
+
+import (
+	"encoding/json"
+	"sort"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+	gophercloud_openstack "github.com/gophercloud/gophercloud/openstack"
+	"github.com/openstack-k8s-operators/lib-common/modules/openstack"
+)
+
+const (
+	// StatusActive - designate status of a resource which got deployed to the DNS servers
+	StatusActive = "ACTIVE"
+	// StatusPending - designate status of a resource while a change gets deployed
+	StatusPending = "PENDING"
+	// StatusError - designate status of a resource which failed to deploy
+	StatusError = "ERROR"
+)
+
+// NewClient - authenticates the user of the authOpts at keystone and returns a client of the
+// designate v2 API at designateURL. The designateURL is the endpoint of the DesignateAPI without
+// the API version, e.g. http://designate-internal.openstack.svc:9001
+func NewClient(authOpts openstack.AuthOpts, designateURL string) (*gophercloud.ServiceClient, error) {
+	provider, err := gophercloud_openstack.NewClient(authOpts.AuthURL)
+	if err != nil {
+		return nil, err
+	}
+
+	err = gophercloud_openstack.AuthenticateV3(provider, &gophercloud.AuthOptions{
+		IdentityEndpoint: authOpts.AuthURL,
+		Username:         authOpts.Username,
+		Password:         authOpts.Password,
+		TenantName:       authOpts.TenantName,
+		DomainName:       authOpts.DomainName,
+		AllowReauth:      true,
+	}, gophercloud.EndpointOpts{})
+	if err != nil {
+		return nil, err
+	}
+
+	// the endpoint gets passed in, there is no need to look it up in the service catalog
+	endpoint := gophercloud.NormalizeURL(strings.TrimSuffix(designateURL, "/"))
+	return &gophercloud.ServiceClient{
+		ProviderClient: provider,
+		Endpoint:       endpoint,
+		ResourceBase:   endpoint + "v2/",
+		Type:           "dns",
+	}, nil
+}
+
+// IsNotFound - returns true if the designate API returned 404 Not Found
+func IsNotFound(err error) bool {
+	_, ok := err.(gophercloud.ErrDefault404)
+	return ok
+}
+
+// ErrorMessage - returns the message designate returned with the error, e.g. why it rejected the
+// request, or the error itself if designate returned none
+func ErrorMessage(err error) string {
+	var body []byte
+	switch e := err.(type) {
+	case gophercloud.ErrDefault400:
+		body = e.Body
+	case gophercloud.ErrDefault403:
+		body = e.Body
+	case gophercloud.ErrDefault409:
+		body = e.Body
+	case gophercloud.ErrDefault500:
+		body = e.Body
+	case gophercloud.ErrUnexpectedResponseCode:
+		body = e.Body
+	}
+
+	designateError := struct {
+		Message string `json:"message"`
+	}{}
+	if json.Unmarshal(body, &designateError) != nil || designateError.Message == "" {
+		return err.Error()
+	}
+	return designateError.Message
+}
+
+// EqualUnordered - returns true if both slices hold the same strings, independent of their order
+func EqualUnordered(a []string, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	a = append([]string{}, a...)
+	b = append([]string{}, b...)
+	sort.Strings(a)
+	sort.Strings(b)
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,115 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package designateclient
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/gophercloud/gophercloud/openstack/dns/v2/zones"
+	"github.com/openstack-k8s-operators/designate-operator/pkg/designateclient/fake"
+)
+
+func TestNewClient(t *testing.T) {
+	server := fake.NewServer()
+	defer server.Close()
+	server.AddZone(fake.Zone{Name: "example.com.", Type: "PRIMARY", Status: "ACTIVE"})
+
+	client, err := NewClient(server.AuthOpts(), server.URL+"/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if client.ResourceBase != server.URL+"/v2/" {
+		t.Errorf("unexpected resource base %s", client.ResourceBase)
+	}
+
+	allPages, err := zones.List(client, zones.ListOpts{}).AllPages()
+	if err != nil {
+		t.Fatal(err)
+	}
+	found, err := zones.ExtractZones(allPages)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(found) != 1 || found[0].Name != "example.com." {
+		t.Errorf("unexpected zones %v", found)
+	}
+}
+
+func TestNewClientWrongPassword(t *testing.T) {
+	server := fake.NewServer()
+	defer server.Close()
+
+	authOpts := server.AuthOpts()
+	authOpts.Password = "wrong"
+	if _, err := NewClient(authOpts, server.URL); err == nil {
+		t.Error("expected an authentication error")
+	}
+}
+
+func TestIsNotFound(t *testing.T) {
+	server := fake.NewServer()
+	defer server.Close()
+
+	client, err := NewClient(server.AuthOpts(), server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = zones.Get(client, "unknown").Extract()
+	if !IsNotFound(err) {
+		t.Errorf("expected a not found error, got %v", err)
+	}
+}
+
+func TestErrorMessage(t *testing.T) {
+	server := fake.NewServer()
+	defer server.Close()
+	server.AddZone(fake.Zone{Name: "example.com.", Type: "PRIMARY", Status: "ACTIVE"})
+
+	client, err := NewClient(server.AuthOpts(), server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = zones.Create(client, zones.CreateOpts{Name: "example.com.", Email: "hostmaster@example.com"}).Extract()
+	if err == nil {
+		t.Fatal("expected a duplicate zone error")
+	}
+	if message := ErrorMessage(err); message != "Duplicate Zone" {
+		t.Errorf("unexpected message %s", message)
+	}
+
+	// errors without a response of designate are returned as they are
+	if message := ErrorMessage(errors.New("connection refused")); message != "connection refused" {
+		t.Errorf("unexpected message %s", message)
+	}
+}
+
+func TestEqualUnordered(t *testing.T) {
+	tests := []struct {
+		a, b  []string
+		equal bool
+	}{
+		{nil, []string{}, true},
+		{[]string{"a", "b"}, []string{"b", "a"}, true},
+		{[]string{"a", "b"}, []string{"a"}, false},
+		{[]string{"a", "a"}, []string{"a", "b"}, false},
+	}
+	for _, tc := range tests {
+		if EqualUnordered(tc.a, tc.b) != tc.equal {
+			t.Errorf("EqualUnordered(%v, %v) != %v", tc.a, tc.b, tc.equal)
+		}
+	}
+}
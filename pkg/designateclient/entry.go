@@ -0,0 +1,145 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package designateclient
+
+import (
+	"net/url"
+
+	"github.com/gophercloud/gophercloud"
+)
+
+// EntryCollection - a designate collection of entries which are identified by a unique attribute
+// besides their ID and only carry a description, e.g. the blacklists identified by their pattern
+// and the TLDs identified by their name
+type EntryCollection struct {
+	// Name - name of the collection in the API path and in the list response, e.g. blacklists
+	Name string
+	// KeyAttribute - name of the attribute which identifies an entry, e.g. pattern
+	KeyAttribute string
+	// EqualKeys - returns true if both values of the key attribute identify the same entry
+	EqualKeys func(a string, b string) bool
+}
+
+// Entry - an entry of an EntryCollection as the designate API returns it
+type Entry struct {
+	ID string
+	// Key - value of the KeyAttribute of the collection
+	Key string
+	// Description - designate returns no description as null
+	Description *string
+}
+
+// Ensure - creates the entry with the key and description, or adopts an entry with the same key
+// which already exists. The entry gets looked up by the id first, e.g. the ID in the status of
+// the custom resource, and gets created again if it got deleted in designate. Corrects the key and
+// description if they drifted. Returns the entry as stored in designate and whether it existed
+// before, without being the entry with the id.
+func (c EntryCollection) Ensure(
+	client *gophercloud.ServiceClient,
+	id string,
+	key string,
+	description string,
+) (*Entry, bool, error) {
+	entry, adopted, err := c.get(client, id, key)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if entry == nil {
+		created := map[string]interface{}{}
+		_, err = client.Post(client.ServiceURL(c.Name), map[string]interface{}{
+			c.KeyAttribute: key,
+			"description":  description,
+		}, &created, nil)
+		if err != nil {
+			return nil, false, err
+		}
+		return c.entry(created), false, nil
+	}
+
+	update := map[string]interface{}{}
+	if !c.EqualKeys(entry.Key, key) {
+		update[c.KeyAttribute] = key
+	}
+	if entry.Description == nil && description != "" ||
+		entry.Description != nil && *entry.Description != description {
+		update["description"] = description
+	}
+	if len(update) == 0 {
+		return entry, adopted, nil
+	}
+
+	updated := map[string]interface{}{}
+	_, err = client.Patch(client.ServiceURL(c.Name, entry.ID), update, &updated, nil)
+	if err != nil {
+		return nil, false, err
+	}
+	return c.entry(updated), adopted, nil
+}
+
+// Delete - deletes the entry, an entry which does not exist anymore is ignored
+func (c EntryCollection) Delete(client *gophercloud.ServiceClient, id string) error {
+	_, err := client.Delete(client.ServiceURL(c.Name, id), nil)
+	if err != nil && !IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// get - returns the entry with the id or the key, or nil if it does not exist. The bool is true if
+// the entry got found by its key.
+func (c EntryCollection) get(client *gophercloud.ServiceClient, id string, key string) (*Entry, bool, error) {
+	if id != "" {
+		found := map[string]interface{}{}
+		_, err := client.Get(client.ServiceURL(c.Name, id), &found, nil)
+		if err == nil {
+			return c.entry(found), false, nil
+		}
+		if !IsNotFound(err) {
+			return nil, false, err
+		}
+	}
+
+	// the list holds the entries besides the links and metadata of the collection
+	list := map[string]interface{}{}
+	query := url.Values{c.KeyAttribute: []string{key}}
+	_, err := client.Get(client.ServiceURL(c.Name)+"?"+query.Encode(), &list, nil)
+	if err != nil {
+		return nil, false, err
+	}
+	entries, _ := list[c.Name].([]interface{})
+	for _, item := range entries {
+		found, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if entry := c.entry(found); c.EqualKeys(entry.Key, key) {
+			return entry, true, nil
+		}
+	}
+	return nil, false, nil
+}
+
+// entry - returns the Entry of an entry the designate API returned
+func (c EntryCollection) entry(raw map[string]interface{}) *Entry {
+	entry := &Entry{}
+	entry.ID, _ = raw["id"].(string)
+	entry.Key, _ = raw[c.KeyAttribute].(string)
+	if description, ok := raw["description"].(string); ok {
+		entry.Description = &description
+	}
+	return entry
+}
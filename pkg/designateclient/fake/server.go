@@ -0,0 +1,835 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package fake provides an in memory fake of keystone and the designate v2 API for tests
+package fake
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/google/uuid"
+	"github.com/openstack-k8s-operators/lib-common/modules/openstack"
+)
+
+const (
+	// Username - user the fake keystone issues tokens for
+	Username = "designate"
+	// Password - password of the Username
+	Password = "12345678"
+	// Token - the token the fake keystone issues and the fake designate API accepts
+	Token = "fake-token"
+	// ProjectID - ID of the project the Token is scoped to, which owns the created zones
+	ProjectID = "fake-project-id"
+)
+
+// DefaultQuotas - the quotas of projects without overrides, the defaults of designate
+var DefaultQuotas = Quotas{
+	Zones:            10,
+	ZoneRecordsets:   500,
+	ZoneRecords:      500,
+	RecordsetRecords: 20,
+	APIExportSize:    1000,
+}
+
+// Zone - a zone as returned by the designate API
+type Zone struct {
+	ID          string   `json:"id"`
+	ProjectID   string   `json:"project_id"`
+	Name        string   `json:"name"`
+	Email       string   `json:"email,omitempty"`
+	TTL         int      `json:"ttl,omitempty"`
+	Description string   `json:"description,omitempty"`
+	Type        string   `json:"type"`
+	Masters     []string `json:"masters"`
+	Serial      int      `json:"serial"`
+	Status      string   `json:"status"`
+	Action      string   `json:"action"`
+}
+
+// RecordSet - a recordset as returned by the designate API
+type RecordSet struct {
+	ID          string   `json:"id"`
+	ZoneID      string   `json:"zone_id"`
+	Name        string   `json:"name"`
+	Type        string   `json:"type"`
+	Records     []string `json:"records"`
+	TTL         *int     `json:"ttl"`
+	Description string   `json:"description,omitempty"`
+	Status      string   `json:"status"`
+	Action      string   `json:"action"`
+}
+
+// Quotas - the quotas of a project as returned by the designate API
+type Quotas struct {
+	Zones            int `json:"zones"`
+	ZoneRecordsets   int `json:"zone_recordsets"`
+	ZoneRecords      int `json:"zone_records"`
+	RecordsetRecords int `json:"recordset_records"`
+	APIExportSize    int `json:"api_export_size"`
+}
+
+// TsigKey - a TSIG key as returned by the designate API
+type TsigKey struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	Algorithm  string `json:"algorithm"`
+	Secret     string `json:"secret"`
+	Scope      string `json:"scope"`
+	ResourceID string `json:"resource_id"`
+}
+
+// Blacklist - a blacklist entry as returned by the designate API
+type Blacklist struct {
+	ID          string `json:"id"`
+	Pattern     string `json:"pattern"`
+	Description string `json:"description"`
+}
+
+// TLD - a TLD as returned by the designate API
+type TLD struct {
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+// Server - an httptest.Server serving the keystone token API at /v3/auth/tokens and the
+// designate v2 API at /v2/. Created zones are ACTIVE right away.
+type Server struct {
+	*httptest.Server
+
+	mu         sync.Mutex
+	zones      map[string]*Zone
+	recordSets map[string]*RecordSet
+	quotas     map[string]*Quotas
+	tsigKeys   map[string]*TsigKey
+	blacklists map[string]*Blacklist
+	tlds       map[string]*TLD
+}
+
+// NewServer - starts a new fake server, which has to be closed by the caller
+func NewServer() *Server {
+	s := &Server{
+		zones:      map[string]*Zone{},
+		recordSets: map[string]*RecordSet{},
+		quotas:     map[string]*Quotas{},
+		tsigKeys:   map[string]*TsigKey{},
+		blacklists: map[string]*Blacklist{},
+		tlds:       map[string]*TLD{},
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v3/auth/tokens", s.handleTokens)
+	mux.HandleFunc("/v2/zones", s.authenticated(s.handleZones))
+	mux.HandleFunc("/v2/zones/", s.authenticated(s.handleZone))
+	mux.HandleFunc("/v2/quotas/", s.authenticated(s.handleQuotas))
+	mux.HandleFunc("/v2/tsigkeys", s.authenticated(s.handleTsigKeys))
+	mux.HandleFunc("/v2/tsigkeys/", s.authenticated(s.handleTsigKey))
+	mux.HandleFunc("/v2/blacklists", s.authenticated(s.handleBlacklists))
+	mux.HandleFunc("/v2/blacklists/", s.authenticated(s.handleBlacklist))
+	mux.HandleFunc("/v2/tlds", s.authenticated(s.handleTLDs))
+	mux.HandleFunc("/v2/tlds/", s.authenticated(s.handleTLD))
+	s.Server = httptest.NewServer(mux)
+
+	return s
+}
+
+// AuthOpts - returns the credentials the fake keystone accepts
+func (s *Server) AuthOpts() openstack.AuthOpts {
+	return openstack.AuthOpts{
+		AuthURL:    s.URL,
+		Username:   Username,
+		Password:   Password,
+		TenantName: "service",
+		DomainName: "Default",
+		Region:     "regionOne",
+	}
+}
+
+// AddZone - adds a zone, as if it got created with the designate API directly. Returns the ID.
+func (s *Server) AddZone(zone Zone) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if zone.ID == "" {
+		zone.ID = uuid.New().String()
+	}
+	if zone.ProjectID == "" {
+		zone.ProjectID = ProjectID
+	}
+	s.zones[zone.ID] = &zone
+	return zone.ID
+}
+
+// GetZone - returns a copy of the zone, or nil if it does not exist
+func (s *Server) GetZone(id string) *Zone {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	zone, ok := s.zones[id]
+	if !ok {
+		return nil
+	}
+	z := *zone
+	return &z
+}
+
+// SetZoneStatus - sets the status and action of the zone, e.g. to simulate a pending change
+func (s *Server) SetZoneStatus(id string, status string, action string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if zone, ok := s.zones[id]; ok {
+		zone.Status = status
+		zone.Action = action
+	}
+}
+
+// DeleteZone - removes the zone, as if it got deleted with the designate API directly
+func (s *Server) DeleteZone(id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.deleteZone(id)
+}
+
+// AddRecordSet - adds a recordset, as if it got created with the designate API directly. Returns the ID.
+func (s *Server) AddRecordSet(recordSet RecordSet) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if recordSet.ID == "" {
+		recordSet.ID = uuid.New().String()
+	}
+	s.recordSets[recordSet.ID] = &recordSet
+	return recordSet.ID
+}
+
+// GetRecordSet - returns a copy of the recordset, or nil if it does not exist
+func (s *Server) GetRecordSet(id string) *RecordSet {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	recordSet, ok := s.recordSets[id]
+	if !ok {
+		return nil
+	}
+	r := *recordSet
+	r.Records = append([]string{}, recordSet.Records...)
+	return &r
+}
+
+// SetRecords - replaces the records of the recordset, e.g. to simulate an out of band change
+func (s *Server) SetRecords(id string, records []string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if recordSet, ok := s.recordSets[id]; ok {
+		recordSet.Records = records
+	}
+}
+
+// GetQuotas - returns the quotas of the project, the DefaultQuotas if they were not changed
+func (s *Server) GetQuotas(projectID string) Quotas {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if quotas, ok := s.quotas[projectID]; ok {
+		return *quotas
+	}
+	return DefaultQuotas
+}
+
+// SetQuotas - sets the quotas of the project, as if they got changed with the designate API directly
+func (s *Server) SetQuotas(projectID string, quotas Quotas) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.quotas[projectID] = &quotas
+}
+
+// AddTsigKey - adds a TSIG key, as if it got created with the designate API directly. Returns the ID.
+func (s *Server) AddTsigKey(tsigKey TsigKey) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if tsigKey.ID == "" {
+		tsigKey.ID = uuid.New().String()
+	}
+	s.tsigKeys[tsigKey.ID] = &tsigKey
+	return tsigKey.ID
+}
+
+// GetTsigKey - returns a copy of the TSIG key, or nil if it does not exist
+func (s *Server) GetTsigKey(id string) *TsigKey {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tsigKey, ok := s.tsigKeys[id]
+	if !ok {
+		return nil
+	}
+	k := *tsigKey
+	return &k
+}
+
+// DeleteTsigKey - removes the TSIG key, as if it got deleted with the designate API directly
+func (s *Server) DeleteTsigKey(id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.tsigKeys, id)
+}
+
+// AddBlacklist - adds a blacklist entry, as if it got created with the designate API directly. Returns the ID.
+func (s *Server) AddBlacklist(blacklist Blacklist) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if blacklist.ID == "" {
+		blacklist.ID = uuid.New().String()
+	}
+	s.blacklists[blacklist.ID] = &blacklist
+	return blacklist.ID
+}
+
+// GetBlacklist - returns a copy of the blacklist entry, or nil if it does not exist
+func (s *Server) GetBlacklist(id string) *Blacklist {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	blacklist, ok := s.blacklists[id]
+	if !ok {
+		return nil
+	}
+	b := *blacklist
+	return &b
+}
+
+// DeleteBlacklist - removes the blacklist entry, as if it got deleted with the designate API directly
+func (s *Server) DeleteBlacklist(id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.blacklists, id)
+}
+
+// AddTLD - adds a TLD, as if it got created with the designate API directly. Returns the ID.
+func (s *Server) AddTLD(tld TLD) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if tld.ID == "" {
+		tld.ID = uuid.New().String()
+	}
+	s.tlds[tld.ID] = &tld
+	return tld.ID
+}
+
+// GetTLD - returns a copy of the TLD, or nil if it does not exist
+func (s *Server) GetTLD(id string) *TLD {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	tld, ok := s.tlds[id]
+	if !ok {
+		return nil
+	}
+	t := *tld
+	return &t
+}
+
+// DeleteTLD - removes the TLD, as if it got deleted with the designate API directly
+func (s *Server) DeleteTLD(id string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.tlds, id)
+}
+
+// deleteZone - deletes the zone and its recordsets, the caller has to hold the lock
+func (s *Server) deleteZone(id string) {
+	delete(s.zones, id)
+	for rid, recordSet := range s.recordSets {
+		if recordSet.ZoneID == id {
+			delete(s.recordSets, rid)
+		}
+	}
+}
+
+func (s *Server) handleTokens(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	var body struct {
+		Auth struct {
+			Identity struct {
+				Password struct {
+					User struct {
+						Name     string `json:"name"`
+						Password string `json:"password"`
+					} `json:"user"`
+				} `json:"password"`
+			} `json:"identity"`
+		} `json:"auth"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		writeError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	user := body.Auth.Identity.Password.User
+	if user.Name != Username || user.Password != Password {
+		writeError(w, http.StatusUnauthorized, "The request you have made requires authentication.")
+		return
+	}
+
+	w.Header().Set("X-Subject-Token", Token)
+	writeJSON(w, http.StatusCreated, map[string]interface{}{
+		"token": map[string]interface{}{
+			"expires_at": "2099-01-01T00:00:00.000000Z",
+			"catalog":    []interface{}{},
+		},
+	})
+}
+
+func (s *Server) authenticated(handler http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Auth-Token") != Token {
+			writeError(w, http.StatusUnauthorized, "Authentication required")
+			return
+		}
+		handler(w, r)
+	}
+}
+
+func (s *Server) handleZones(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch r.Method {
+	case http.MethodGet:
+		name := r.URL.Query().Get("name")
+		allProjects := isAllProjects(r)
+		projectID := requestProjectID(r)
+		zones := []*Zone{}
+		for _, zone := range s.zones {
+			if (allProjects || zone.ProjectID == projectID) && (name == "" || zone.Name == name) {
+				zones = append(zones, zone)
+			}
+		}
+		// the total count does not depend on the limit of the page
+		metadata := map[string]int{"total_count": len(zones)}
+		if limit, err := strconv.Atoi(r.URL.Query().Get("limit")); err == nil && limit < len(zones) {
+			zones = zones[:limit]
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"zones": zones, "links": map[string]string{}, "metadata": metadata})
+	case http.MethodPost:
+		zone := &Zone{}
+		if err := json.NewDecoder(r.Body).Decode(zone); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		for _, z := range s.zones {
+			if z.Name == zone.Name {
+				writeError(w, http.StatusConflict, "Duplicate Zone")
+				return
+			}
+		}
+		if zone.Type == "" {
+			zone.Type = "PRIMARY"
+		}
+		zone.ID = uuid.New().String()
+		zone.ProjectID = ProjectID
+		zone.Serial = 1
+		zone.Status = "ACTIVE"
+		zone.Action = "NONE"
+		s.zones[zone.ID] = zone
+		writeJSON(w, http.StatusAccepted, zone)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (s *Server) handleZone(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// /v2/zones/<id>[/recordsets[/<id>]]
+	path := strings.Split(strings.TrimPrefix(r.URL.Path, "/v2/zones/"), "/")
+	id := path[0]
+	zone, ok := s.zones[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("Could not find Zone %s", id))
+		return
+	}
+
+	switch {
+	case len(path) == 2 && path[1] == "recordsets":
+		s.handleRecordSets(w, r, zone)
+		return
+	case len(path) == 3 && path[1] == "recordsets":
+		s.handleRecordSet(w, r, zone, path[2])
+		return
+	case len(path) != 1:
+		writeError(w, http.StatusNotFound, "Not Found")
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, zone)
+	case http.MethodPatch:
+		if err := json.NewDecoder(r.Body).Decode(zone); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		zone.ID = id
+		zone.Serial++
+		writeJSON(w, http.StatusAccepted, zone)
+	case http.MethodDelete:
+		s.deleteZone(id)
+		zone.Status = "PENDING"
+		zone.Action = "DELETE"
+		writeJSON(w, http.StatusAccepted, zone)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (s *Server) handleRecordSets(w http.ResponseWriter, r *http.Request, zone *Zone) {
+	switch r.Method {
+	case http.MethodGet:
+		name := r.URL.Query().Get("name")
+		recordSetType := r.URL.Query().Get("type")
+		recordSets := []*RecordSet{}
+		for _, recordSet := range s.recordSets {
+			if recordSet.ZoneID == zone.ID &&
+				(name == "" || recordSet.Name == name) &&
+				(recordSetType == "" || recordSet.Type == recordSetType) {
+				recordSets = append(recordSets, recordSet)
+			}
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"recordsets": recordSets, "links": map[string]string{}})
+	case http.MethodPost:
+		recordSet := &RecordSet{}
+		if err := json.NewDecoder(r.Body).Decode(recordSet); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		if !strings.HasSuffix(recordSet.Name, zone.Name) {
+			writeError(w, http.StatusBadRequest, "RecordSet is not contained within it's parent zone")
+			return
+		}
+		for _, rs := range s.recordSets {
+			if rs.ZoneID == zone.ID && rs.Name == recordSet.Name && rs.Type == recordSet.Type {
+				writeError(w, http.StatusConflict, "Duplicate RecordSet")
+				return
+			}
+		}
+		recordSet.ID = uuid.New().String()
+		recordSet.ZoneID = zone.ID
+		recordSet.Status = "ACTIVE"
+		recordSet.Action = "NONE"
+		s.recordSets[recordSet.ID] = recordSet
+		zone.Serial++
+		writeJSON(w, http.StatusAccepted, recordSet)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (s *Server) handleRecordSet(w http.ResponseWriter, r *http.Request, zone *Zone, id string) {
+	recordSet, ok := s.recordSets[id]
+	if !ok || recordSet.ZoneID != zone.ID {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("Could not find RecordSet %s", id))
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, recordSet)
+	case http.MethodPut:
+		if err := json.NewDecoder(r.Body).Decode(recordSet); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		recordSet.ID = id
+		zone.Serial++
+		writeJSON(w, http.StatusAccepted, recordSet)
+	case http.MethodDelete:
+		delete(s.recordSets, id)
+		zone.Serial++
+		recordSet.Status = "PENDING"
+		recordSet.Action = "DELETE"
+		writeJSON(w, http.StatusAccepted, recordSet)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (s *Server) handleQuotas(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	// the quotas of other projects are only accessible with all projects requests
+	projectID := strings.TrimPrefix(r.URL.Path, "/v2/quotas/")
+	if projectID != ProjectID && !isAllProjects(r) {
+		writeError(w, http.StatusForbidden, "You do not have permission to perform this action")
+		return
+	}
+
+	quotas, ok := s.quotas[projectID]
+	if !ok {
+		defaults := DefaultQuotas
+		quotas = &defaults
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, quotas)
+	case http.MethodPatch:
+		if err := json.NewDecoder(r.Body).Decode(quotas); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		s.quotas[projectID] = quotas
+		writeJSON(w, http.StatusOK, quotas)
+	case http.MethodDelete:
+		delete(s.quotas, projectID)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (s *Server) handleTsigKeys(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch r.Method {
+	case http.MethodGet:
+		name := r.URL.Query().Get("name")
+		tsigKeys := []*TsigKey{}
+		for _, tsigKey := range s.tsigKeys {
+			if name == "" || tsigKey.Name == name {
+				tsigKeys = append(tsigKeys, tsigKey)
+			}
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"tsigkeys": tsigKeys, "links": map[string]string{}})
+	case http.MethodPost:
+		tsigKey := &TsigKey{}
+		if err := json.NewDecoder(r.Body).Decode(tsigKey); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		for _, k := range s.tsigKeys {
+			if k.Name == tsigKey.Name {
+				writeError(w, http.StatusConflict, "Duplicate TsigKey")
+				return
+			}
+		}
+		tsigKey.ID = uuid.New().String()
+		s.tsigKeys[tsigKey.ID] = tsigKey
+		writeJSON(w, http.StatusCreated, tsigKey)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (s *Server) handleTsigKey(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := strings.TrimPrefix(r.URL.Path, "/v2/tsigkeys/")
+	tsigKey, ok := s.tsigKeys[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("Could not find TsigKey %s", id))
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, tsigKey)
+	case http.MethodPatch:
+		if err := json.NewDecoder(r.Body).Decode(tsigKey); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		tsigKey.ID = id
+		writeJSON(w, http.StatusOK, tsigKey)
+	case http.MethodDelete:
+		delete(s.tsigKeys, id)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (s *Server) handleBlacklists(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch r.Method {
+	case http.MethodGet:
+		pattern := r.URL.Query().Get("pattern")
+		blacklists := []*Blacklist{}
+		for _, blacklist := range s.blacklists {
+			if pattern == "" || blacklist.Pattern == pattern {
+				blacklists = append(blacklists, blacklist)
+			}
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"blacklists": blacklists, "links": map[string]string{}})
+	case http.MethodPost:
+		blacklist := &Blacklist{}
+		if err := json.NewDecoder(r.Body).Decode(blacklist); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		for _, b := range s.blacklists {
+			if b.Pattern == blacklist.Pattern {
+				writeError(w, http.StatusConflict, "Duplicate Blacklist")
+				return
+			}
+		}
+		blacklist.ID = uuid.New().String()
+		s.blacklists[blacklist.ID] = blacklist
+		writeJSON(w, http.StatusCreated, blacklist)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (s *Server) handleBlacklist(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := strings.TrimPrefix(r.URL.Path, "/v2/blacklists/")
+	blacklist, ok := s.blacklists[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("Could not find Blacklist %s", id))
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, blacklist)
+	case http.MethodPatch:
+		if err := json.NewDecoder(r.Body).Decode(blacklist); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		blacklist.ID = id
+		writeJSON(w, http.StatusOK, blacklist)
+	case http.MethodDelete:
+		delete(s.blacklists, id)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (s *Server) handleTLDs(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	switch r.Method {
+	case http.MethodGet:
+		name := r.URL.Query().Get("name")
+		tlds := []*TLD{}
+		for _, tld := range s.tlds {
+			if name == "" || tld.Name == name {
+				tlds = append(tlds, tld)
+			}
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"tlds": tlds, "links": map[string]string{}})
+	case http.MethodPost:
+		tld := &TLD{}
+		if err := json.NewDecoder(r.Body).Decode(tld); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		for _, t := range s.tlds {
+			if t.Name == tld.Name {
+				writeError(w, http.StatusConflict, "Duplicate Tld")
+				return
+			}
+		}
+		tld.ID = uuid.New().String()
+		s.tlds[tld.ID] = tld
+		writeJSON(w, http.StatusCreated, tld)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (s *Server) handleTLD(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id := strings.TrimPrefix(r.URL.Path, "/v2/tlds/")
+	tld, ok := s.tlds[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, fmt.Sprintf("Could not find Tld %s", id))
+		return
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		writeJSON(w, http.StatusOK, tld)
+	case http.MethodPatch:
+		if err := json.NewDecoder(r.Body).Decode(tld); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		tld.ID = id
+		writeJSON(w, http.StatusOK, tld)
+	case http.MethodDelete:
+		delete(s.tlds, id)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+// isAllProjects - returns true if the request is for the resources of all projects
+func isAllProjects(r *http.Request) bool {
+	return strings.EqualFold(r.Header.Get("X-Auth-All-Projects"), "true")
+}
+
+// requestProjectID - returns the project the request is for, the project of the Token or the
+// project an admin impersonates with the sudo header
+func requestProjectID(r *http.Request) string {
+	if projectID := r.Header.Get("X-Auth-Sudo-Project-ID"); projectID != "" {
+		return projectID
+	}
+	return ProjectID
+}
+
+func writeJSON(w http.ResponseWriter, code int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeError(w http.ResponseWriter, code int, message string) {
+	writeJSON(w, code, map[string]interface{}{"code": code, "message": message})
+}
@@ -0,0 +1,159 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package designatequota
+
+import (
+	"github.com/gophercloud/gophercloud"
+	designatev1 "github.com/openstack-k8s-operators/designate-operator/api/v1beta1"
+	"github.com/openstack-k8s-operators/designate-operator/pkg/designateclient"
+)
+
+// Quotas - the quotas of a project as the designate admin quotas API returns them
+type Quotas struct {
+	Zones            int `json:"zones"`
+	ZoneRecordsets   int `json:"zone_recordsets"`
+	ZoneRecords      int `json:"zone_records"`
+	RecordsetRecords int `json:"recordset_records"`
+	APIExportSize    int `json:"api_export_size"`
+}
+
+// Values - returns the quotas in the format of the DesignateQuota status
+func (q Quotas) Values() *designatev1.DesignateQuotaValues {
+	return &designatev1.DesignateQuotaValues{
+		Zones:            int32(q.Zones),
+		ZoneRecordsets:   int32(q.ZoneRecordsets),
+		ZoneRecords:      int32(q.ZoneRecords),
+		RecordsetRecords: int32(q.RecordsetRecords),
+		APIExportSize:    int32(q.APIExportSize),
+	}
+}
+
+// DesiredQuotas - returns the quotas of the project, the overrides of the DesignateQuota spec or the
+// defaults of the DesignateAPI
+func DesiredQuotas(instance *designatev1.DesignateQuota, defaults designatev1.DesignateAPIQuotas) Quotas {
+	value := func(override *int32, def int32) int {
+		if override != nil {
+			return int(*override)
+		}
+		return int(def)
+	}
+
+	return Quotas{
+		Zones:            value(instance.Spec.Zones, defaults.Zones),
+		ZoneRecordsets:   value(instance.Spec.ZoneRecordsets, defaults.ZoneRecordsets),
+		ZoneRecords:      value(instance.Spec.ZoneRecords, defaults.ZoneRecords),
+		RecordsetRecords: value(instance.Spec.RecordsetRecords, defaults.RecordsetRecords),
+		APIExportSize:    value(instance.Spec.APIExportSize, defaults.APIExportSize),
+	}
+}
+
+// EnsureQuotas - sets the quotas of the project of the DesignateQuota which differ from the
+// DesiredQuotas, e.g. because they got changed with the designate API directly. Returns the quotas
+// designate reports for the project.
+func EnsureQuotas(
+	client *gophercloud.ServiceClient,
+	instance *designatev1.DesignateQuota,
+	defaults designatev1.DesignateAPIQuotas,
+) (*Quotas, error) {
+	current, err := GetQuotas(client, instance.Spec.ProjectID)
+	if err != nil {
+		return nil, err
+	}
+
+	desired := DesiredQuotas(instance, defaults)
+	patch := map[string]int{}
+	if current.Zones != desired.Zones {
+		patch["zones"] = desired.Zones
+	}
+	if current.ZoneRecordsets != desired.ZoneRecordsets {
+		patch["zone_recordsets"] = desired.ZoneRecordsets
+	}
+	if current.ZoneRecords != desired.ZoneRecords {
+		patch["zone_records"] = desired.ZoneRecords
+	}
+	if current.RecordsetRecords != desired.RecordsetRecords {
+		patch["recordset_records"] = desired.RecordsetRecords
+	}
+	if current.APIExportSize != desired.APIExportSize {
+		patch["api_export_size"] = desired.APIExportSize
+	}
+	if len(patch) == 0 {
+		return current, nil
+	}
+
+	updated := &Quotas{}
+	_, err = allProjects(client).Patch(client.ServiceURL("quotas", instance.Spec.ProjectID), patch, updated, nil)
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+// GetQuotas - returns the quotas of the project
+func GetQuotas(client *gophercloud.ServiceClient, projectID string) (*Quotas, error) {
+	quotas := &Quotas{}
+	_, err := allProjects(client).Get(client.ServiceURL("quotas", projectID), quotas, nil)
+	if err != nil {
+		return nil, err
+	}
+	return quotas, nil
+}
+
+// ResetQuotas - resets the quotas of the project to the defaults of designate
+func ResetQuotas(client *gophercloud.ServiceClient, projectID string) error {
+	_, err := allProjects(client).Delete(client.ServiceURL("quotas", projectID), nil)
+	if err != nil && !designateclient.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// CountZones - returns the number of zones of the project. The zones are listed as the project with
+// a single entry page, designate counts all zones of the project in the metadata of the list.
+func CountZones(client *gophercloud.ServiceClient, projectID string) (int, error) {
+	list := struct {
+		Metadata struct {
+			TotalCount int `json:"total_count"`
+		} `json:"metadata"`
+	}{}
+	_, err := sudoProject(client, projectID).Get(client.ServiceURL("zones")+"?limit=1", &list, nil)
+	if err != nil {
+		return 0, err
+	}
+	return list.Metadata.TotalCount, nil
+}
+
+// allProjects - returns a copy of the client which accesses the resources of all projects, the
+// service user is not a member of the projects it manages the quotas of
+func allProjects(client *gophercloud.ServiceClient) *gophercloud.ServiceClient {
+	return withHeader(client, "X-Auth-All-Projects", "True")
+}
+
+// sudoProject - returns a copy of the client which accesses the resources of the project only, as if
+// the service user was a member of the project
+func sudoProject(client *gophercloud.ServiceClient, projectID string) *gophercloud.ServiceClient {
+	return withHeader(client, "X-Auth-Sudo-Project-ID", projectID)
+}
+
+// withHeader - returns a copy of the client which sends the header with every request
+func withHeader(client *gophercloud.ServiceClient, key string, value string) *gophercloud.ServiceClient {
+	c := *client
+	c.MoreHeaders = map[string]string{key: value}
+	for k, v := range client.MoreHeaders {
+		c.MoreHeaders[k] = v
+	}
+	return &c
+}
@@ -0,0 +1,147 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package designatequota
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	designatev1 "github.com/openstack-k8s-operators/designate-operator/api/v1beta1"
+	"github.com/openstack-k8s-operators/designate-operator/pkg/designateclient"
+	"github.com/openstack-k8s-operators/designate-operator/pkg/designateclient/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const testProjectID = "0123456789abcdef0123456789abcdef"
+
+// defaults - the defaults of the DesignateAPI, which match the ones of the fake server
+var defaults = designatev1.DesignateAPIQuotas{
+	Zones:            10,
+	ZoneRecordsets:   500,
+	ZoneRecords:      500,
+	RecordsetRecords: 20,
+	APIExportSize:    1000,
+}
+
+func newTestClient(t *testing.T) (*fake.Server, *gophercloud.ServiceClient) {
+	t.Helper()
+
+	server := fake.NewServer()
+	t.Cleanup(server.Close)
+
+	client, err := designateclient.NewClient(server.AuthOpts(), server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return server, client
+}
+
+func newTestInstance() *designatev1.DesignateQuota {
+	zones := int32(50)
+	return &designatev1.DesignateQuota{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "demo-project",
+			Namespace: "openstack",
+		},
+		Spec: designatev1.DesignateQuotaSpec{
+			DesignateAPI: "designate",
+			ProjectID:    testProjectID,
+			Zones:        &zones,
+		},
+	}
+}
+
+func TestEnsureQuotasSetsOverrides(t *testing.T) {
+	server, client := newTestClient(t)
+
+	quotas, err := EnsureQuotas(client, newTestInstance(), defaults)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := fake.DefaultQuotas
+	expected.Zones = 50
+	if server.GetQuotas(testProjectID) != expected {
+		t.Errorf("unexpected quotas %+v", server.GetQuotas(testProjectID))
+	}
+	if quotas.Zones != 50 || quotas.ZoneRecordsets != 500 {
+		t.Errorf("unexpected applied quotas %+v", quotas)
+	}
+}
+
+func TestEnsureQuotasCorrectsDrift(t *testing.T) {
+	server, client := newTestClient(t)
+	instance := newTestInstance()
+
+	// an override which got removed from the spec and a change with the designate API directly
+	drifted := fake.DefaultQuotas
+	drifted.Zones = 5
+	drifted.RecordsetRecords = 100
+	server.SetQuotas(testProjectID, drifted)
+
+	if _, err := EnsureQuotas(client, instance, defaults); err != nil {
+		t.Fatal(err)
+	}
+
+	expected := fake.DefaultQuotas
+	expected.Zones = 50
+	if server.GetQuotas(testProjectID) != expected {
+		t.Errorf("drift not corrected, quotas %+v", server.GetQuotas(testProjectID))
+	}
+}
+
+func TestEnsureQuotasFollowsDefaults(t *testing.T) {
+	server, client := newTestClient(t)
+	instance := newTestInstance()
+	changed := defaults
+	changed.ZoneRecords = 1000
+
+	if _, err := EnsureQuotas(client, instance, changed); err != nil {
+		t.Fatal(err)
+	}
+	if quotas := server.GetQuotas(testProjectID); quotas.ZoneRecords != 1000 {
+		t.Errorf("default not applied, quotas %+v", quotas)
+	}
+}
+
+func TestResetQuotas(t *testing.T) {
+	server, client := newTestClient(t)
+
+	if _, err := EnsureQuotas(client, newTestInstance(), defaults); err != nil {
+		t.Fatal(err)
+	}
+	if err := ResetQuotas(client, testProjectID); err != nil {
+		t.Fatal(err)
+	}
+	if server.GetQuotas(testProjectID) != fake.DefaultQuotas {
+		t.Errorf("quotas not reset, quotas %+v", server.GetQuotas(testProjectID))
+	}
+}
+
+func TestCountZones(t *testing.T) {
+	server, client := newTestClient(t)
+	server.AddZone(fake.Zone{Name: "a.example.com.", ProjectID: testProjectID})
+	server.AddZone(fake.Zone{Name: "b.example.com.", ProjectID: testProjectID})
+	server.AddZone(fake.Zone{Name: "example.org."})
+
+	count, err := CountZones(client, testProjectID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Errorf("expected 2 zones, got %d", count)
+	}
+}
@@ -0,0 +1,124 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package designaterecordset
+
+import (
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/dns/v2/recordsets"
+	designatev1 "github.com/openstack-k8s-operators/designate-operator/api/v1beta1"
+	"github.com/openstack-k8s-operators/designate-operator/pkg/designateclient"
+)
+
+// EnsureRecordSet - creates the recordset of the DesignateRecordSet in the zone with the zoneID and
+// corrects the records, TTL and description if they drifted from the spec, e.g. because they got
+// changed with the designate API directly. Returns the recordset as stored in designate and whether
+// it existed before, without being the recordset of the Status.RecordSetID.
+func EnsureRecordSet(
+	client *gophercloud.ServiceClient,
+	zoneID string,
+	instance *designatev1.DesignateRecordSet,
+) (*recordsets.RecordSet, bool, error) {
+	recordSet, adopted, err := getRecordSet(client, zoneID, instance)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if recordSet == nil {
+		recordSet, err = recordsets.Create(client, zoneID, recordsets.CreateOpts{
+			Name:        instance.Spec.Name,
+			Type:        instance.Spec.Type,
+			Records:     instance.Spec.Records,
+			TTL:         int(instance.Spec.TTL),
+			Description: instance.Spec.Description,
+		}).Extract()
+		return recordSet, false, err
+	}
+
+	opts, changed := updateOpts(instance, recordSet)
+	if !changed {
+		return recordSet, adopted, nil
+	}
+	recordSet, err = recordsets.Update(client, zoneID, recordSet.ID, opts).Extract()
+	return recordSet, adopted, err
+}
+
+// DeleteRecordSet - deletes the recordset, a recordset or zone which does not exist anymore is ignored
+func DeleteRecordSet(client *gophercloud.ServiceClient, zoneID string, recordSetID string) error {
+	err := recordsets.Delete(client, zoneID, recordSetID).Err
+	if err != nil && !designateclient.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// getRecordSet - returns the recordset with the Status.RecordSetID or the name and type of the spec,
+// or nil if it does not exist. A recordset which got deleted in designate gets created again. The bool
+// is true if the recordset got found by its name and type.
+func getRecordSet(
+	client *gophercloud.ServiceClient,
+	zoneID string,
+	instance *designatev1.DesignateRecordSet,
+) (*recordsets.RecordSet, bool, error) {
+	if instance.Status.RecordSetID != "" && instance.Status.ZoneID == zoneID {
+		recordSet, err := recordsets.Get(client, zoneID, instance.Status.RecordSetID).Extract()
+		if err == nil {
+			return recordSet, false, nil
+		}
+		if !designateclient.IsNotFound(err) {
+			return nil, false, err
+		}
+	}
+
+	allPages, err := recordsets.ListByZone(client, zoneID, recordsets.ListOpts{
+		Name: instance.Spec.Name,
+		Type: instance.Spec.Type,
+	}).AllPages()
+	if err != nil {
+		return nil, false, err
+	}
+	found, err := recordsets.ExtractRecordSets(allPages)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(found) == 0 {
+		return nil, false, nil
+	}
+	return &found[0], true, nil
+}
+
+// updateOpts - returns the update of the attributes of the recordset which differ from the spec
+func updateOpts(instance *designatev1.DesignateRecordSet, recordSet *recordsets.RecordSet) (recordsets.UpdateOpts, bool) {
+	opts := recordsets.UpdateOpts{}
+	changed := false
+
+	if !designateclient.EqualUnordered(instance.Spec.Records, recordSet.Records) {
+		opts.Records = instance.Spec.Records
+		changed = true
+	}
+	// a TTL of 0 resets the TTL to the one of the zone
+	if int(instance.Spec.TTL) != recordSet.TTL {
+		ttl := int(instance.Spec.TTL)
+		opts.TTL = &ttl
+		changed = true
+	}
+	if instance.Spec.Description != recordSet.Description {
+		description := instance.Spec.Description
+		opts.Description = &description
+		changed = true
+	}
+
+	return opts, changed
+}
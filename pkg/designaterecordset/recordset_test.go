@@ -0,0 +1,189 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package designaterecordset
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	designatev1 "github.com/openstack-k8s-operators/designate-operator/api/v1beta1"
+	"github.com/openstack-k8s-operators/designate-operator/pkg/designateclient"
+	"github.com/openstack-k8s-operators/designate-operator/pkg/designateclient/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// newTestClient - returns a client of a fake server, which holds the zone example.com.
+func newTestClient(t *testing.T) (*fake.Server, *gophercloud.ServiceClient, string) {
+	t.Helper()
+
+	server := fake.NewServer()
+	t.Cleanup(server.Close)
+	zoneID := server.AddZone(fake.Zone{
+		Name:   "example.com.",
+		Type:   "PRIMARY",
+		Status: designateclient.StatusActive,
+	})
+
+	client, err := designateclient.NewClient(server.AuthOpts(), server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return server, client, zoneID
+}
+
+func newTestInstance() *designatev1.DesignateRecordSet {
+	return &designatev1.DesignateRecordSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "www-example-com",
+			Namespace: "openstack",
+		},
+		Spec: designatev1.DesignateRecordSetSpec{
+			ZoneRef: "example-com",
+			Name:    "www.example.com.",
+			Type:    "A",
+			Records: []string{"192.0.2.10", "192.0.2.11"},
+			TTL:     300,
+		},
+	}
+}
+
+func TestEnsureRecordSetCreates(t *testing.T) {
+	server, client, zoneID := newTestClient(t)
+
+	recordSet, adopted, err := EnsureRecordSet(client, zoneID, newTestInstance())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if adopted {
+		t.Error("created recordset reported as adopted")
+	}
+
+	created := server.GetRecordSet(recordSet.ID)
+	if created == nil {
+		t.Fatalf("recordset %s not created", recordSet.ID)
+	}
+	if created.ZoneID != zoneID || created.Type != "A" || *created.TTL != 300 || len(created.Records) != 2 {
+		t.Errorf("unexpected recordset %+v", created)
+	}
+	if recordSet.Status != designateclient.StatusActive {
+		t.Errorf("unexpected status %s", recordSet.Status)
+	}
+}
+
+func TestEnsureRecordSetCorrectsDrift(t *testing.T) {
+	server, client, zoneID := newTestClient(t)
+	instance := newTestInstance()
+
+	recordSet, _, err := EnsureRecordSet(client, zoneID, instance)
+	if err != nil {
+		t.Fatal(err)
+	}
+	instance.Status.ZoneID = zoneID
+	instance.Status.RecordSetID = recordSet.ID
+
+	// the order of the records does not matter
+	server.SetRecords(recordSet.ID, []string{"192.0.2.11", "192.0.2.10"})
+	if _, _, err = EnsureRecordSet(client, zoneID, instance); err != nil {
+		t.Fatal(err)
+	}
+	if records := server.GetRecordSet(recordSet.ID).Records; records[0] != "192.0.2.11" {
+		t.Errorf("recordset got updated without changes, records %v", records)
+	}
+
+	server.SetRecords(recordSet.ID, []string{"198.51.100.1"})
+	if _, _, err = EnsureRecordSet(client, zoneID, instance); err != nil {
+		t.Fatal(err)
+	}
+	if records := server.GetRecordSet(recordSet.ID).Records; !designateclient.EqualUnordered(records, instance.Spec.Records) {
+		t.Errorf("drift not corrected, records %v", records)
+	}
+}
+
+func TestEnsureRecordSetResetsTTL(t *testing.T) {
+	server, client, zoneID := newTestClient(t)
+	instance := newTestInstance()
+
+	recordSet, _, err := EnsureRecordSet(client, zoneID, instance)
+	if err != nil {
+		t.Fatal(err)
+	}
+	instance.Status.ZoneID = zoneID
+	instance.Status.RecordSetID = recordSet.ID
+
+	instance.Spec.TTL = 0
+	if _, _, err = EnsureRecordSet(client, zoneID, instance); err != nil {
+		t.Fatal(err)
+	}
+	if ttl := server.GetRecordSet(recordSet.ID).TTL; ttl != nil {
+		t.Errorf("TTL %d not reset to the one of the zone", *ttl)
+	}
+}
+
+func TestEnsureRecordSetAdoptsExistingRecordSet(t *testing.T) {
+	server, client, zoneID := newTestClient(t)
+	ttl := 300
+	id := server.AddRecordSet(fake.RecordSet{
+		ZoneID:  zoneID,
+		Name:    "www.example.com.",
+		Type:    "A",
+		Records: []string{"192.0.2.10", "192.0.2.11"},
+		TTL:     &ttl,
+		Status:  designateclient.StatusActive,
+	})
+
+	recordSet, adopted, err := EnsureRecordSet(client, zoneID, newTestInstance())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if recordSet.ID != id || !adopted {
+		t.Errorf("recordset %s not adopted", id)
+	}
+}
+
+func TestEnsureRecordSetOutsideOfZone(t *testing.T) {
+	_, client, zoneID := newTestClient(t)
+	instance := newTestInstance()
+	instance.Spec.Name = "www.example.org."
+
+	if _, _, err := EnsureRecordSet(client, zoneID, instance); err == nil {
+		t.Error("expected an error for a recordset outside of the zone")
+	}
+}
+
+func TestDeleteRecordSet(t *testing.T) {
+	server, client, zoneID := newTestClient(t)
+
+	recordSet, _, err := EnsureRecordSet(client, zoneID, newTestInstance())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := DeleteRecordSet(client, zoneID, recordSet.ID); err != nil {
+		t.Fatal(err)
+	}
+	if server.GetRecordSet(recordSet.ID) != nil {
+		t.Error("recordset not deleted")
+	}
+
+	// deleting a recordset which does not exist anymore succeeds
+	if err := DeleteRecordSet(client, zoneID, recordSet.ID); err != nil {
+		t.Fatal(err)
+	}
+	// as well as deleting a recordset of a deleted zone
+	server.DeleteZone(zoneID)
+	if err := DeleteRecordSet(client, zoneID, recordSet.ID); err != nil {
+		t.Fatal(err)
+	}
+}
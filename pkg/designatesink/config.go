@@ -0,0 +1,49 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package designatesink
+
+import (
+	"strings"
+
+	designatev1 "github.com/openstack-k8s-operators/designate-operator/api/v1beta1"
+	"github.com/openstack-k8s-operators/designate-operator/pkg/designate"
+)
+
+// TemplateParameters - returns the parameters of the config templates of the DesignateSink. The
+// handlers subscribe to the notifications topic the designate services publish to.
+func TemplateParameters(instance *designatev1.DesignateSink) map[string]interface{} {
+	return map[string]interface{}{
+		"NotificationsTopic":          designate.NotificationsTopic,
+		"EnabledNotificationHandlers": strings.Join(instance.GetEnabledHandlers(), ","),
+		"NovaFixed":                   instance.Spec.Handlers.NovaFixed,
+		"NeutronFloatingIP":           instance.Spec.Handlers.NeutronFloatingIP,
+	}
+}
+
+// SecretTemplateParameters - returns the templateParameters extended by the transport_url, which holds
+// the password of the message bus and only gets rendered into the designate.conf
+func SecretTemplateParameters(
+	templateParameters map[string]interface{},
+	transportURL string,
+) map[string]interface{} {
+	secretParameters := map[string]interface{}{}
+	for key, value := range templateParameters {
+		secretParameters[key] = value
+	}
+	secretParameters["TransportURL"] = transportURL
+
+	return secretParameters
+}
@@ -0,0 +1,30 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package designatesink
+
+const (
+	// ComponentName -
+	ComponentName = "designate-sink"
+
+	// KollaConfig -
+	KollaConfig = "/var/lib/config-data/default/designate-sink-config.json"
+
+	// ServiceConfigTemplate - template of the designate.conf of the sink, relative to the templates dir
+	ServiceConfigTemplate = "designatesink/merged/designate.conf"
+
+	// ServiceCommand -
+	ServiceCommand = "/usr/local/bin/kolla_set_configs && /usr/local/bin/kolla_start"
+)
@@ -0,0 +1,111 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package designatesink
+
+import (
+	designatev1 "github.com/openstack-k8s-operators/designate-operator/api/v1beta1"
+	"github.com/openstack-k8s-operators/designate-operator/pkg/designate"
+	"github.com/openstack-k8s-operators/lib-common/modules/common"
+	"github.com/openstack-k8s-operators/lib-common/modules/common/affinity"
+	"github.com/openstack-k8s-operators/lib-common/modules/common/env"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ResourceName - name of the Deployment and the config Secrets of the DesignateSink, suffixed to not
+// collide with the objects of a DesignateAPI with the same name
+func ResourceName(instance *designatev1.DesignateSink) string {
+	return instance.Name + "-sink"
+}
+
+// Deployment func
+func Deployment(
+	instance *designatev1.DesignateSink,
+	configHash string,
+	labels map[string]string,
+) *appsv1.Deployment {
+	runAsUser := int64(0)
+
+	// designate-sink only consumes notifications and has no API to probe,
+	// a failing connection to the message bus terminates the process
+	args := []string{"-c"}
+	if instance.Spec.Debug.Service {
+		args = append(args, common.DebugCommand)
+	} else {
+		args = append(args, ServiceCommand)
+	}
+
+	envVars := map[string]env.Setter{}
+	envVars["KOLLA_CONFIG_FILE"] = env.SetValue(KollaConfig)
+	envVars["KOLLA_CONFIG_STRATEGY"] = env.SetValue("COPY_ALWAYS")
+	envVars["CONFIG_HASH"] = env.SetValue(configHash)
+
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ResourceName(instance),
+			Namespace: instance.Namespace,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Selector: &metav1.LabelSelector{
+				MatchLabels: labels,
+			},
+			Replicas: &instance.Spec.Replicas,
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels: labels,
+				},
+				Spec: corev1.PodSpec{
+					ServiceAccountName: designate.ServiceAccount,
+					Containers: []corev1.Container{
+						{
+							Name: ComponentName,
+							Command: []string{
+								"/bin/bash",
+							},
+							Args:  args,
+							Image: instance.Spec.ContainerImage,
+							SecurityContext: &corev1.SecurityContext{
+								RunAsUser: &runAsUser,
+							},
+							Env:          env.MergeEnvs([]corev1.EnvVar{}, envVars),
+							VolumeMounts: getVolumeMounts(),
+							Resources:    instance.Spec.Resources,
+						},
+					},
+					Volumes: getVolumes(ResourceName(instance)),
+				},
+			},
+		},
+	}
+
+	// If possible two pods of the same service should not
+	// run on the same worker node. If this is not possible
+	// the get still created on the same worker node.
+	deployment.Spec.Template.Spec.Affinity = affinity.DistributePods(
+		designate.OwnerNameLabel,
+		[]string{
+			instance.Name,
+		},
+		corev1.LabelHostname,
+	)
+	if len(instance.Spec.NodeSelector) > 0 {
+		deployment.Spec.Template.Spec.NodeSelector = instance.Spec.NodeSelector
+	}
+
+	return deployment
+}
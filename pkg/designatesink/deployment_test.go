@@ -0,0 +1,62 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package designatesink
+
+import (
+	"testing"
+
+	designatev1 "github.com/openstack-k8s-operators/designate-operator/api/v1beta1"
+)
+
+func TestDeployment(t *testing.T) {
+	tests := []struct {
+		name   string
+		golden string
+		mutate func(instance *designatev1.DesignateSink)
+	}{
+		{
+			name:   "default",
+			golden: "deployment/default.yaml",
+			mutate: func(instance *designatev1.DesignateSink) {},
+		},
+		{
+			name:   "debug service",
+			golden: "deployment/debug.yaml",
+			mutate: func(instance *designatev1.DesignateSink) {
+				instance.Spec.Debug.Service = true
+			},
+		},
+		{
+			name:   "node selector and replicas",
+			golden: "deployment/node-selector.yaml",
+			mutate: func(instance *designatev1.DesignateSink) {
+				instance.Spec.Replicas = 2
+				instance.Spec.NodeSelector = map[string]string{
+					"node-role.kubernetes.io/worker": "",
+				}
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			instance := newTestInstance()
+			tc.mutate(instance)
+
+			assertGoldenYAML(t, tc.golden, Deployment(instance, "n5b8h5c9h", testLabels))
+		})
+	}
+}
@@ -0,0 +1,110 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package designatesink
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	designatev1 "github.com/openstack-k8s-operators/designate-operator/api/v1beta1"
+	"github.com/openstack-k8s-operators/designate-operator/pkg/designate"
+	"github.com/openstack-k8s-operators/lib-common/modules/common"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+// update - regenerates the golden files from the current output, run with
+// go test ./pkg/designatesink/... -update
+var update = flag.Bool("update", false, "update the golden files in testdata")
+
+// TestMain - points the lib-common template helpers to the templates of the operator
+func TestMain(m *testing.M) {
+	templates, err := filepath.Abs(filepath.Join("..", "..", "templates"))
+	if err != nil {
+		panic(err)
+	}
+	if err := os.Setenv("OPERATOR_TEMPLATES", templates+"/"); err != nil {
+		panic(err)
+	}
+
+	os.Exit(m.Run())
+}
+
+// assertGolden - compares actual with the content of testdata/<name>, or writes
+// actual to the file when the tests run with -update
+func assertGolden(t *testing.T, name string, actual string) {
+	t.Helper()
+
+	golden := filepath.Join("testdata", name)
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(golden), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(golden, []byte(actual), 0644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+
+	expected, err := os.ReadFile(golden)
+	if err != nil {
+		t.Fatalf("%v, run the tests with -update to create the golden file", err)
+	}
+	if diff := cmp.Diff(string(expected), actual); diff != "" {
+		t.Errorf("%s mismatch (-expected +actual):\n%s", golden, diff)
+	}
+}
+
+// assertGoldenYAML - marshals obj to YAML and compares it with testdata/<name>
+func assertGoldenYAML(t *testing.T, name string, obj interface{}) {
+	t.Helper()
+
+	actual, err := yaml.Marshal(obj)
+	if err != nil {
+		t.Fatal(err)
+	}
+	assertGolden(t, name, string(actual))
+}
+
+// newTestInstance - returns a DesignateSink as it gets passed to the builders after the defaulting
+// of the API server
+func newTestInstance() *designatev1.DesignateSink {
+	return &designatev1.DesignateSink{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "designate",
+			Namespace: "openstack",
+		},
+		Spec: designatev1.DesignateSinkSpec{
+			ContainerImage: "quay.io/tripleozedcentos9/openstack-designate-sink:current-tripleo",
+			Replicas:       1,
+			Handlers: designatev1.DesignateSinkHandlers{
+				NovaFixed: &designatev1.DesignateSinkHandler{
+					ZoneID: "7e4ab4b8-3b8a-4c07-9a15-7b0b5e3c0e52",
+				},
+			},
+		},
+	}
+}
+
+// testLabels - the labels the controller passes to the builders
+var testLabels = map[string]string{
+	common.AppSelector:       ComponentName,
+	designate.OwnerNameLabel: "designate-sink",
+}
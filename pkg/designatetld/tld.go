@@ -0,0 +1,52 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package designatetld
+
+import (
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+	designatev1 "github.com/openstack-k8s-operators/designate-operator/api/v1beta1"
+	"github.com/openstack-k8s-operators/designate-operator/pkg/designateclient"
+)
+
+// tlds - the TLDs of designate, identified by their name
+var tlds = designateclient.EntryCollection{
+	Name:         "tlds",
+	KeyAttribute: "name",
+	EqualKeys:    EqualNames,
+}
+
+// EqualNames - returns true if both names identify the same TLD, designate compares the names
+// case insensitive
+func EqualNames(a string, b string) bool {
+	return strings.EqualFold(a, b)
+}
+
+// EnsureTLD - creates the TLD of the DesignateTLD, or adopts a TLD with the same name which already
+// exists. Corrects the name and description if they drifted from the spec. Returns the TLD as stored
+// in designate and whether it existed before, without being the TLD of the Status.TLDID.
+func EnsureTLD(
+	client *gophercloud.ServiceClient,
+	instance *designatev1.DesignateTLD,
+) (*designateclient.Entry, bool, error) {
+	return tlds.Ensure(client, instance.Status.TLDID, instance.Spec.Name, instance.Spec.Description)
+}
+
+// DeleteTLD - deletes the TLD, a TLD which does not exist anymore is ignored
+func DeleteTLD(client *gophercloud.ServiceClient, tldID string) error {
+	return tlds.Delete(client, tldID)
+}
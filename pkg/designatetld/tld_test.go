@@ -0,0 +1,137 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package designatetld
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	designatev1 "github.com/openstack-k8s-operators/designate-operator/api/v1beta1"
+	"github.com/openstack-k8s-operators/designate-operator/pkg/designateclient"
+	"github.com/openstack-k8s-operators/designate-operator/pkg/designateclient/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newTestClient(t *testing.T) (*fake.Server, *gophercloud.ServiceClient) {
+	t.Helper()
+
+	server := fake.NewServer()
+	t.Cleanup(server.Close)
+
+	client, err := designateclient.NewClient(server.AuthOpts(), server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return server, client
+}
+
+func newTestInstance() *designatev1.DesignateTLD {
+	return &designatev1.DesignateTLD{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "com",
+			Namespace: "openstack",
+		},
+		Spec: designatev1.DesignateTLDSpec{
+			DesignateAPI: "designate",
+			Name:         "com",
+		},
+	}
+}
+
+func TestEnsureTLDCreates(t *testing.T) {
+	server, client := newTestClient(t)
+
+	tld, adopted, err := EnsureTLD(client, newTestInstance())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if adopted {
+		t.Error("created TLD reported as adopted")
+	}
+
+	expected := fake.TLD{ID: tld.ID, Name: "com"}
+	if actual := server.GetTLD(tld.ID); actual == nil || *actual != expected {
+		t.Errorf("unexpected TLD %+v", actual)
+	}
+}
+
+func TestEnsureTLDUpdates(t *testing.T) {
+	server, client := newTestClient(t)
+	instance := newTestInstance()
+
+	tld, _, err := EnsureTLD(client, instance)
+	if err != nil {
+		t.Fatal(err)
+	}
+	instance.Status.TLDID = tld.ID
+
+	instance.Spec.Name = "co.uk"
+	instance.Spec.Description = "United Kingdom"
+	tld, adopted, err := EnsureTLD(client, instance)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if adopted || tld.ID != instance.Status.TLDID {
+		t.Errorf("unexpected TLD %+v, adopted %t", tld, adopted)
+	}
+	actual := server.GetTLD(tld.ID)
+	if actual.Name != "co.uk" || actual.Description != "United Kingdom" {
+		t.Errorf("TLD not updated %+v", actual)
+	}
+}
+
+func TestEnsureTLDAdoptsAndRecreates(t *testing.T) {
+	server, client := newTestClient(t)
+	instance := newTestInstance()
+	id := server.AddTLD(fake.TLD{Name: "com"})
+
+	tld, adopted, err := EnsureTLD(client, instance)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !adopted || tld.ID != id {
+		t.Errorf("TLD not adopted %+v", tld)
+	}
+	instance.Status.TLDID = id
+
+	server.DeleteTLD(id)
+	tld, adopted, err = EnsureTLD(client, instance)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if adopted || tld.ID == id || server.GetTLD(tld.ID) == nil {
+		t.Errorf("TLD not created again %+v", tld)
+	}
+}
+
+func TestDeleteTLD(t *testing.T) {
+	server, client := newTestClient(t)
+
+	tld, _, err := EnsureTLD(client, newTestInstance())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := DeleteTLD(client, tld.ID); err != nil {
+		t.Fatal(err)
+	}
+	if server.GetTLD(tld.ID) != nil {
+		t.Error("TLD not deleted")
+	}
+	// deleting a TLD which does not exist anymore succeeds
+	if err := DeleteTLD(client, tld.ID); err != nil {
+		t.Fatal(err)
+	}
+}
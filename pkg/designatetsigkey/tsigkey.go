@@ -0,0 +1,183 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package designatetsigkey
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/gophercloud/gophercloud"
+	designatev1 "github.com/openstack-k8s-operators/designate-operator/api/v1beta1"
+	"github.com/openstack-k8s-operators/designate-operator/pkg/designateclient"
+)
+
+const (
+	// DefaultPoolID - ID of the default pool designate creates
+	DefaultPoolID = "794ccc2c-d751-44fe-b57f-8894c9f5c842"
+
+	// SecretKeyName - key of the name of the TSIG key in the Secret
+	SecretKeyName = "name"
+	// SecretKeyAlgorithm - key of the algorithm of the TSIG key in the Secret
+	SecretKeyAlgorithm = "algorithm"
+	// SecretKeySecret - key of the base64 encoded secret of the TSIG key in the Secret
+	SecretKeySecret = "secret"
+	// SecretKeyBindConfig - key of the key statement of the TSIG key for the BIND config in the Secret
+	SecretKeyBindConfig = "tsigkey.conf"
+)
+
+// secretSizes - size of the generated secrets, the output size of the hash function of the algorithm
+var secretSizes = map[string]int{
+	"hmac-md5":    16,
+	"hmac-sha1":   20,
+	"hmac-sha224": 28,
+	"hmac-sha256": 32,
+	"hmac-sha384": 48,
+	"hmac-sha512": 64,
+}
+
+// TsigKey - a TSIG key as the designate API returns it
+type TsigKey struct {
+	ID         string `json:"id,omitempty"`
+	Name       string `json:"name,omitempty"`
+	Algorithm  string `json:"algorithm,omitempty"`
+	Secret     string `json:"secret,omitempty"`
+	Scope      string `json:"scope,omitempty"`
+	ResourceID string `json:"resource_id,omitempty"`
+}
+
+// SecretName - returns the name of the Secret holding the TSIG key of the DesignateTsigKey
+func SecretName(instance *designatev1.DesignateTsigKey) string {
+	return instance.Name + "-tsigkey"
+}
+
+// GenerateSecret - returns a random base64 encoded secret for a TSIG key with the algorithm
+func GenerateSecret(algorithm string) (string, error) {
+	size, ok := secretSizes[algorithm]
+	if !ok {
+		return "", fmt.Errorf("unsupported TSIG key algorithm %s", algorithm)
+	}
+
+	secret := make([]byte, size)
+	if _, err := rand.Read(secret); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(secret), nil
+}
+
+// BindConfig - returns the key statement of the TSIG key for the BIND config
+func BindConfig(name string, algorithm string, secret string) string {
+	return fmt.Sprintf("key \"%s\" {\n  algorithm %s;\n  secret \"%s\";\n};\n", name, algorithm, secret)
+}
+
+// EnsureTsigKey - registers the TSIG key of the DesignateTsigKey with the secret and attaches it to
+// the pool or zone with the resourceID. Corrects the key if it drifted, e.g. because it got changed
+// with the designate API directly. Returns the key as stored in designate and whether it existed
+// before, without being the key of the Status.TsigKeyID.
+func EnsureTsigKey(
+	client *gophercloud.ServiceClient,
+	instance *designatev1.DesignateTsigKey,
+	secret string,
+	resourceID string,
+) (*TsigKey, bool, error) {
+	desired := TsigKey{
+		Name:       instance.Spec.Name,
+		Algorithm:  instance.Spec.Algorithm,
+		Secret:     secret,
+		Scope:      string(instance.Spec.Scope),
+		ResourceID: resourceID,
+	}
+
+	tsigKey, adopted, err := GetTsigKey(client, instance)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if tsigKey == nil {
+		created := &TsigKey{}
+		_, err = client.Post(client.ServiceURL("tsigkeys"), desired, created, nil)
+		if err != nil {
+			return nil, false, err
+		}
+		return created, false, nil
+	}
+
+	desired.Name = ""
+	if tsigKey.Algorithm == desired.Algorithm {
+		desired.Algorithm = ""
+	}
+	if tsigKey.Secret == desired.Secret {
+		desired.Secret = ""
+	}
+	if tsigKey.Scope == desired.Scope {
+		desired.Scope = ""
+	}
+	if tsigKey.ResourceID == desired.ResourceID {
+		desired.ResourceID = ""
+	}
+	if desired == (TsigKey{}) {
+		return tsigKey, adopted, nil
+	}
+
+	updated := &TsigKey{}
+	_, err = client.Patch(client.ServiceURL("tsigkeys", tsigKey.ID), desired, updated, nil)
+	if err != nil {
+		return nil, false, err
+	}
+	return updated, adopted, nil
+}
+
+// DeleteTsigKey - deletes the TSIG key, a key which does not exist anymore is ignored
+func DeleteTsigKey(client *gophercloud.ServiceClient, tsigKeyID string) error {
+	_, err := client.Delete(client.ServiceURL("tsigkeys", tsigKeyID), nil)
+	if err != nil && !designateclient.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// GetTsigKey - returns the key with the Status.TsigKeyID or the name of the spec, or nil if it does
+// not exist. A key which got deleted in designate gets created again. The bool is true if the key
+// got found by its name.
+func GetTsigKey(client *gophercloud.ServiceClient, instance *designatev1.DesignateTsigKey) (*TsigKey, bool, error) {
+	if instance.Status.TsigKeyID != "" {
+		tsigKey := &TsigKey{}
+		_, err := client.Get(client.ServiceURL("tsigkeys", instance.Status.TsigKeyID), tsigKey, nil)
+		if err == nil {
+			return tsigKey, false, nil
+		}
+		if !designateclient.IsNotFound(err) {
+			return nil, false, err
+		}
+	}
+
+	list := struct {
+		TsigKeys []TsigKey `json:"tsigkeys"`
+	}{}
+	query := url.Values{"name": []string{instance.Spec.Name}}
+	_, err := client.Get(client.ServiceURL("tsigkeys")+"?"+query.Encode(), &list, nil)
+	if err != nil {
+		return nil, false, err
+	}
+	for _, tsigKey := range list.TsigKeys {
+		if strings.EqualFold(tsigKey.Name, instance.Spec.Name) {
+			return &tsigKey, true, nil
+		}
+	}
+	return nil, false, nil
+}
@@ -0,0 +1,186 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package designatetsigkey
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	designatev1 "github.com/openstack-k8s-operators/designate-operator/api/v1beta1"
+	"github.com/openstack-k8s-operators/designate-operator/pkg/designateclient"
+	"github.com/openstack-k8s-operators/designate-operator/pkg/designateclient/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newTestClient(t *testing.T) (*fake.Server, *gophercloud.ServiceClient) {
+	t.Helper()
+
+	server := fake.NewServer()
+	t.Cleanup(server.Close)
+
+	client, err := designateclient.NewClient(server.AuthOpts(), server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return server, client
+}
+
+func newTestInstance() *designatev1.DesignateTsigKey {
+	return &designatev1.DesignateTsigKey{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "transfer-key",
+			Namespace: "openstack",
+		},
+		Spec: designatev1.DesignateTsigKeySpec{
+			DesignateAPI: "designate",
+			Name:         "transfer-key",
+			Algorithm:    "hmac-sha256",
+			Scope:        designatev1.TsigKeyScopePool,
+		},
+	}
+}
+
+func TestGenerateSecret(t *testing.T) {
+	for algorithm, size := range secretSizes {
+		secret, err := GenerateSecret(algorithm)
+		if err != nil {
+			t.Fatal(err)
+		}
+		decoded, err := base64.StdEncoding.DecodeString(secret)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(decoded) != size {
+			t.Errorf("%s: expected a secret of %d bytes, got %d", algorithm, size, len(decoded))
+		}
+	}
+
+	first, _ := GenerateSecret("hmac-sha256")
+	second, _ := GenerateSecret("hmac-sha256")
+	if first == second {
+		t.Error("generated the same secret twice")
+	}
+
+	if _, err := GenerateSecret("hmac-sha3"); err == nil {
+		t.Error("expected an error for an unsupported algorithm")
+	}
+}
+
+func TestBindConfig(t *testing.T) {
+	expected := `key "transfer-key" {
+  algorithm hmac-sha256;
+  secret "c2VjcmV0";
+};
+`
+	if actual := BindConfig("transfer-key", "hmac-sha256", "c2VjcmV0"); actual != expected {
+		t.Errorf("unexpected config %q", actual)
+	}
+}
+
+func TestEnsureTsigKeyCreates(t *testing.T) {
+	server, client := newTestClient(t)
+
+	tsigKey, adopted, err := EnsureTsigKey(client, newTestInstance(), "c2VjcmV0", DefaultPoolID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if adopted {
+		t.Error("created key reported as adopted")
+	}
+
+	expected := fake.TsigKey{
+		ID:         tsigKey.ID,
+		Name:       "transfer-key",
+		Algorithm:  "hmac-sha256",
+		Secret:     "c2VjcmV0",
+		Scope:      "POOL",
+		ResourceID: DefaultPoolID,
+	}
+	if actual := server.GetTsigKey(tsigKey.ID); actual == nil || *actual != expected {
+		t.Errorf("unexpected key %+v", actual)
+	}
+}
+
+func TestEnsureTsigKeyUpdates(t *testing.T) {
+	server, client := newTestClient(t)
+	instance := newTestInstance()
+
+	tsigKey, _, err := EnsureTsigKey(client, instance, "c2VjcmV0", DefaultPoolID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	instance.Status.TsigKeyID = tsigKey.ID
+
+	// a rotated secret and a key moved to a zone
+	instance.Spec.Scope = designatev1.TsigKeyScopeZone
+	if _, _, err := EnsureTsigKey(client, instance, "cm90YXRlZA==", "zone-id"); err != nil {
+		t.Fatal(err)
+	}
+	actual := server.GetTsigKey(tsigKey.ID)
+	if actual.Secret != "cm90YXRlZA==" || actual.Scope != "ZONE" || actual.ResourceID != "zone-id" {
+		t.Errorf("key not updated %+v", actual)
+	}
+}
+
+func TestEnsureTsigKeyAdoptsAndRecreates(t *testing.T) {
+	server, client := newTestClient(t)
+	instance := newTestInstance()
+	id := server.AddTsigKey(fake.TsigKey{
+		Name:       "transfer-key",
+		Algorithm:  "hmac-md5",
+		Secret:     "b2xk",
+		Scope:      "POOL",
+		ResourceID: DefaultPoolID,
+	})
+
+	tsigKey, adopted, err := EnsureTsigKey(client, instance, "c2VjcmV0", DefaultPoolID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tsigKey.ID != id || !adopted || tsigKey.Algorithm != "hmac-sha256" || tsigKey.Secret != "c2VjcmV0" {
+		t.Errorf("key not adopted %+v", tsigKey)
+	}
+	instance.Status.TsigKeyID = id
+
+	server.DeleteTsigKey(id)
+	tsigKey, _, err = EnsureTsigKey(client, instance, "c2VjcmV0", DefaultPoolID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tsigKey.ID == id || server.GetTsigKey(tsigKey.ID) == nil {
+		t.Errorf("key not created again %+v", tsigKey)
+	}
+}
+
+func TestDeleteTsigKey(t *testing.T) {
+	server, client := newTestClient(t)
+
+	tsigKey, _, err := EnsureTsigKey(client, newTestInstance(), "c2VjcmV0", DefaultPoolID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := DeleteTsigKey(client, tsigKey.ID); err != nil {
+		t.Fatal(err)
+	}
+	if server.GetTsigKey(tsigKey.ID) != nil {
+		t.Error("key not deleted")
+	}
+	// deleting a key which does not exist anymore succeeds
+	if err := DeleteTsigKey(client, tsigKey.ID); err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,122 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package designatezone
+
+import (
+	"fmt"
+
+	"github.com/gophercloud/gophercloud"
+	"github.com/gophercloud/gophercloud/openstack/dns/v2/zones"
+	designatev1 "github.com/openstack-k8s-operators/designate-operator/api/v1beta1"
+	"github.com/openstack-k8s-operators/designate-operator/pkg/designateclient"
+)
+
+// EnsureZone - creates the zone of the DesignateZone in designate and updates the attributes which
+// differ from the spec. The zone gets looked up by Status.ZoneID, or by name if it is not known,
+// e.g. because the zone got created with the CLI before. Returns the zone as stored in designate and
+// whether it existed before, without being the zone of the Status.ZoneID.
+func EnsureZone(client *gophercloud.ServiceClient, instance *designatev1.DesignateZone) (*zones.Zone, bool, error) {
+	zone, adopted, err := getZone(client, instance)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if zone == nil {
+		zone, err = zones.Create(client, zones.CreateOpts{
+			Name:        instance.Spec.Name,
+			Email:       instance.Spec.Email,
+			TTL:         int(instance.Spec.TTL),
+			Type:        string(instance.Spec.Type),
+			Masters:     instance.Spec.Masters,
+			Description: instance.Spec.Description,
+		}).Extract()
+		return zone, false, err
+	}
+
+	if zone.Type != string(instance.Spec.Type) {
+		return nil, false, fmt.Errorf("zone %s exists with type %s", zone.Name, zone.Type)
+	}
+
+	opts, changed := updateOpts(instance, zone)
+	if !changed {
+		return zone, adopted, nil
+	}
+	zone, err = zones.Update(client, zone.ID, opts).Extract()
+	return zone, adopted, err
+}
+
+// DeleteZone - deletes the zone with the zoneID, a zone which does not exist anymore is ignored
+func DeleteZone(client *gophercloud.ServiceClient, zoneID string) error {
+	err := zones.Delete(client, zoneID).Err
+	if err != nil && !designateclient.IsNotFound(err) {
+		return err
+	}
+	return nil
+}
+
+// getZone - returns the zone with the Status.ZoneID or the name of the spec, or nil if it does
+// not exist. A zone which got deleted in designate gets created again. The bool is true if the
+// zone got found by its name.
+func getZone(client *gophercloud.ServiceClient, instance *designatev1.DesignateZone) (*zones.Zone, bool, error) {
+	if instance.Status.ZoneID != "" {
+		zone, err := zones.Get(client, instance.Status.ZoneID).Extract()
+		if err == nil {
+			return zone, false, nil
+		}
+		if !designateclient.IsNotFound(err) {
+			return nil, false, err
+		}
+	}
+
+	allPages, err := zones.List(client, zones.ListOpts{Name: instance.Spec.Name}).AllPages()
+	if err != nil {
+		return nil, false, err
+	}
+	found, err := zones.ExtractZones(allPages)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(found) == 0 {
+		return nil, false, nil
+	}
+	return &found[0], true, nil
+}
+
+// updateOpts - returns the update of the attributes of the zone which differ from the spec
+func updateOpts(instance *designatev1.DesignateZone, zone *zones.Zone) (zones.UpdateOpts, bool) {
+	opts := zones.UpdateOpts{}
+	changed := false
+
+	if instance.Spec.Email != "" && instance.Spec.Email != zone.Email {
+		opts.Email = instance.Spec.Email
+		changed = true
+	}
+	if instance.Spec.TTL > 0 && int(instance.Spec.TTL) != zone.TTL {
+		opts.TTL = int(instance.Spec.TTL)
+		changed = true
+	}
+	if instance.Spec.Type == designatev1.ZoneTypeSecondary && !designateclient.EqualUnordered(instance.Spec.Masters, zone.Masters) {
+		opts.Masters = instance.Spec.Masters
+		changed = true
+	}
+	if instance.Spec.Description != zone.Description {
+		description := instance.Spec.Description
+		opts.Description = &description
+		changed = true
+	}
+
+	return opts, changed
+}
@@ -0,0 +1,226 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package designatezone
+
+import (
+	"testing"
+
+	"github.com/gophercloud/gophercloud"
+	designatev1 "github.com/openstack-k8s-operators/designate-operator/api/v1beta1"
+	"github.com/openstack-k8s-operators/designate-operator/pkg/designateclient"
+	"github.com/openstack-k8s-operators/designate-operator/pkg/designateclient/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newTestClient(t *testing.T) (*fake.Server, *gophercloud.ServiceClient) {
+	t.Helper()
+
+	server := fake.NewServer()
+	t.Cleanup(server.Close)
+
+	client, err := designateclient.NewClient(server.AuthOpts(), server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return server, client
+}
+
+func newTestInstance() *designatev1.DesignateZone {
+	return &designatev1.DesignateZone{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "example-com",
+			Namespace: "openstack",
+		},
+		Spec: designatev1.DesignateZoneSpec{
+			DesignateAPI: "designate",
+			Name:         "example.com.",
+			Email:        "hostmaster@example.com",
+			TTL:          3600,
+			Type:         designatev1.ZoneTypePrimary,
+		},
+	}
+}
+
+func TestEnsureZoneCreates(t *testing.T) {
+	server, client := newTestClient(t)
+	instance := newTestInstance()
+
+	zone, adopted, err := EnsureZone(client, instance)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if adopted {
+		t.Error("created zone reported as adopted")
+	}
+
+	created := server.GetZone(zone.ID)
+	if created == nil {
+		t.Fatalf("zone %s not created", zone.ID)
+	}
+	if created.Name != "example.com." || created.Email != "hostmaster@example.com" || created.TTL != 3600 {
+		t.Errorf("unexpected zone %+v", created)
+	}
+	if zone.Status != designateclient.StatusActive || zone.Serial != 1 {
+		t.Errorf("unexpected status %s or serial %d", zone.Status, zone.Serial)
+	}
+}
+
+func TestEnsureZoneAdoptsExistingZone(t *testing.T) {
+	server, client := newTestClient(t)
+	id := server.AddZone(fake.Zone{
+		Name:   "example.com.",
+		Email:  "hostmaster@example.com",
+		TTL:    3600,
+		Type:   "PRIMARY",
+		Serial: 42,
+		Status: designateclient.StatusActive,
+		Action: "NONE",
+	})
+
+	instance := newTestInstance()
+	zone, adopted, err := EnsureZone(client, instance)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if zone.ID != id || zone.Serial != 42 || !adopted {
+		t.Errorf("zone %s with serial %d not adopted", zone.ID, zone.Serial)
+	}
+
+	// the zone of the Status.ZoneID is not adopted again
+	instance.Status.ZoneID = zone.ID
+	if _, adopted, err = EnsureZone(client, instance); err != nil {
+		t.Fatal(err)
+	}
+	if adopted {
+		t.Error("zone of the status reported as adopted")
+	}
+}
+
+func TestEnsureZoneUpdates(t *testing.T) {
+	server, client := newTestClient(t)
+	instance := newTestInstance()
+
+	zone, _, err := EnsureZone(client, instance)
+	if err != nil {
+		t.Fatal(err)
+	}
+	instance.Status.ZoneID = zone.ID
+
+	// no changes, no update
+	zone, _, err = EnsureZone(client, instance)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if zone.Serial != 1 {
+		t.Errorf("zone got updated without changes, serial %d", zone.Serial)
+	}
+
+	instance.Spec.TTL = 300
+	instance.Spec.Description = "updated"
+	zone, _, err = EnsureZone(client, instance)
+	if err != nil {
+		t.Fatal(err)
+	}
+	updated := server.GetZone(zone.ID)
+	if updated.TTL != 300 || updated.Description != "updated" || updated.Email != "hostmaster@example.com" {
+		t.Errorf("unexpected zone %+v", updated)
+	}
+	if zone.Serial != 2 {
+		t.Errorf("unexpected serial %d", zone.Serial)
+	}
+}
+
+func TestEnsureZoneRecreatesDeletedZone(t *testing.T) {
+	server, client := newTestClient(t)
+	instance := newTestInstance()
+
+	zone, _, err := EnsureZone(client, instance)
+	if err != nil {
+		t.Fatal(err)
+	}
+	instance.Status.ZoneID = zone.ID
+	server.DeleteZone(zone.ID)
+
+	recreated, _, err := EnsureZone(client, instance)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if recreated.ID == zone.ID || server.GetZone(recreated.ID) == nil {
+		t.Errorf("zone not recreated")
+	}
+}
+
+func TestEnsureZoneTypeMismatch(t *testing.T) {
+	server, client := newTestClient(t)
+	server.AddZone(fake.Zone{
+		Name:    "example.com.",
+		Type:    "SECONDARY",
+		Masters: []string{"192.0.2.1:53"},
+		Status:  designateclient.StatusActive,
+	})
+
+	if _, _, err := EnsureZone(client, newTestInstance()); err == nil {
+		t.Error("expected an error for a zone of another type")
+	}
+}
+
+func TestEnsureSecondaryZoneMasters(t *testing.T) {
+	server, client := newTestClient(t)
+	instance := newTestInstance()
+	instance.Spec.Type = designatev1.ZoneTypeSecondary
+	instance.Spec.Email = ""
+	instance.Spec.Masters = []string{"192.0.2.1:53", "192.0.2.2:53"}
+
+	zone, _, err := EnsureZone(client, instance)
+	if err != nil {
+		t.Fatal(err)
+	}
+	instance.Status.ZoneID = zone.ID
+
+	// the order of the masters does not matter
+	instance.Spec.Masters = []string{"192.0.2.2:53", "192.0.2.1:53"}
+	if zone, _, err = EnsureZone(client, instance); err != nil {
+		t.Fatal(err)
+	}
+	if zone.Serial != 1 {
+		t.Errorf("zone got updated without changes, serial %d", zone.Serial)
+	}
+
+	instance.Spec.Masters = []string{"192.0.2.3:53"}
+	if zone, _, err = EnsureZone(client, instance); err != nil {
+		t.Fatal(err)
+	}
+	if masters := server.GetZone(zone.ID).Masters; len(masters) != 1 || masters[0] != "192.0.2.3:53" {
+		t.Errorf("unexpected masters %v", masters)
+	}
+}
+
+func TestDeleteZone(t *testing.T) {
+	server, client := newTestClient(t)
+	id := server.AddZone(fake.Zone{Name: "example.com.", Type: "PRIMARY", Status: designateclient.StatusActive})
+
+	if err := DeleteZone(client, id); err != nil {
+		t.Fatal(err)
+	}
+	if server.GetZone(id) != nil {
+		t.Error("zone not deleted")
+	}
+
+	// deleting a zone which does not exist anymore succeeds
+	if err := DeleteZone(client, id); err != nil {
+		t.Fatal(err)
+	}
+}